@@ -0,0 +1,54 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// AlertLog is an alert that Site24x7 sent, e.g. an email about a monitor
+// going down
+type AlertLog struct {
+	MonitorID   string `json:"monitor_id,omitempty"`
+	MonitorName string `json:"display_name,omitempty"`
+	Message     string `json:"msg"`
+	SentTime    string `json:"sent_time"`
+	AlertType   string `json:"alert_type,omitempty"` // how it was sent, e.g. Email
+}
+
+// AlertLogs returns the alerts sent on a date (yyyy-mm-dd)
+// https://www.site24x7.com/help/api/#alert-logs
+func (c *Client) AlertLogs(ctx context.Context, date string) ([]AlertLog, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/alert_logs", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body: nil,
+		QueryString: url.Values{
+			"date": {date},
+		},
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving alert logs; message: %s", res.Message)
+	}
+
+	return decode[[]AlertLog](res.Data)
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// AlertLogList returns Client.AlertLogs as json for the current profile
+func AlertLogList(ctx context.Context, date string) (json.RawMessage, error) {
+	return encode(defaultClient().AlertLogs(ctx, date))
+}
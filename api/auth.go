@@ -6,12 +6,12 @@
 package api
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
-
-	"github.com/spf13/viper"
+	"site24x7/config"
+	"site24x7/logger"
 )
 
 // AuthToken contains the data returned from a call to exchange either a grant or
@@ -27,14 +27,14 @@ type AuthToken struct {
 
 // Configure exchanges a short-lived grant token (a.k.a. authorization code) and
 // returns a long-lived refresh token.
-func Configure(grantToken string) (string, error) {
+func Configure(ctx context.Context, grantToken string) (string, error) {
 	exchangableToken := map[string]string{
 		"grantType": "authorization_code",
 		"key":       "code",
 		"value":     grantToken,
 	}
 
-	t, err := exchangeToken(exchangableToken)
+	t, err := exchangeProfileToken(ctx, exchangableToken)
 	if err != nil {
 		return "", err
 	}
@@ -42,56 +42,100 @@ func Configure(grantToken string) (string, error) {
 	return t.RefreshToken, nil
 }
 
-// Authenticate exchanges a refresh token for a short-lived access token and
-// stores the latter for use in subsequent API calls.
-func Authenticate() error {
+// Authenticate stores a short-lived access token for use in subsequent API
+// calls. A token cached by an earlier command is reused until shortly before
+// it expires; only then is the refresh token exchanged for a new one.
+func Authenticate(ctx context.Context) error {
+	if t := readCachedToken(); t != nil && t.valid() {
+		profileTokens.token = t.AccessToken
+		return nil
+	}
+
+	return refreshAccessToken(ctx)
+}
+
+// refreshAccessToken exchanges a refresh token for a short-lived access token,
+// caches the latter and stores it for use in subsequent API calls.
+func refreshAccessToken(ctx context.Context) error {
 	exchangableToken := map[string]string{
 		"grantType": "refresh_token",
 		"key":       "refresh_token",
-		"value":     viper.GetString("auth.refresh_token"),
+		"value":     config.GetString("auth.refresh_token"),
 	}
 
-	t, err := exchangeToken(exchangableToken)
+	t, err := exchangeProfileToken(ctx, exchangableToken)
 	if err != nil {
 		return err
 	}
 
-	os.Setenv("AUTH_ACCESS_TOKEN", t.AccessToken)
+	// A failure to cache only costs us a token exchange next time
+	if err := writeCachedToken(t); err != nil {
+		logger.Warn(fmt.Sprintf("[api.refreshAccessToken] Unable to cache the access token (%s)", err))
+	}
+
+	profileTokens.token = t.AccessToken
 
 	return nil
 }
 
+// Credentials identify a registered Site24x7 (Zoho) application and the
+// refresh token that an account granted it
+// https://www.site24x7.com/help/api/#authentication
+type Credentials struct {
+	ClientID     string
+	ClientSecret string
+	RefreshToken string
+}
+
+// profileCredentials returns the credentials of the current configuration
+// profile
+func profileCredentials() Credentials {
+	return Credentials{
+		ClientID:     config.GetString("auth.client_id"),
+		ClientSecret: config.GetString("auth.client_secret"),
+		RefreshToken: config.GetString("auth.refresh_token"),
+	}
+}
+
+// exchangeProfileToken exchanges a token using the credentials, and at the
+// data center, of the current configuration profile
+func exchangeProfileToken(ctx context.Context, token map[string]string) (*AuthToken, error) {
+	// Credentials only exist in a single data center, so don't send them
+	// anywhere if we can't tell which one that is
+	if _, err := CurrentDataCenter(); err != nil {
+		return nil, fmt.Errorf("[Auth.exchangeToken] ERROR: %s", err)
+	}
+
+	return exchangeToken(ctx, httpClient, authBaseURL(), profileCredentials(), token)
+}
+
 // exchangeToken exchanges a grant token (aka "authorization code") for a
 // refresh token or a refresh token for an access token.
-func exchangeToken(token map[string]string) (*AuthToken, error) {
+func exchangeToken(ctx context.Context, hc *http.Client, authBaseURL string, creds Credentials, token map[string]string) (*AuthToken, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/oauth/v2/token", os.Getenv("AUTH_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/oauth/v2/token", authBaseURL),
 		Method:   "POST",
 		Headers: http.Header{
 			"Content-Type": {"application/x-www-form-urlencoded"},
 		},
 		Body: nil,
 		QueryString: url.Values{
-			"client_id":     {viper.GetString("auth.client_id")},
-			"client_secret": {viper.GetString("auth.client_secret")},
+			"client_id":     {creds.ClientID},
+			"client_secret": {creds.ClientSecret},
 			"grant_type":    {token["grantType"]},
 			token["key"]:    {token["value"]},
 		},
+		client: &Client{HTTPClient: hc},
 	}
 
-	t, err := req.FetchAuthToken()
+	t, err := req.FetchAuthToken(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if t.Error != nil {
-		return nil, fmt.Errorf("[Auth.exchangeToken] ERROR: received an error response from Site24x7 (%s)", *t.Error)
+		// e.g. invalid_code, when a grant or refresh token is expired or revoked
+		return nil, &UnauthorizedError{Message: fmt.Sprintf("[Auth.exchangeToken] ERROR: received an error response from Site24x7 (%s)", *t.Error)}
 	}
 
 	return t, nil
 }
-
-// httpHeader returns the header name and value required to authenticate each
-// API request sent to Site24x7.
-func httpHeader() (string, string) {
-	return "Authorization", fmt.Sprintf("Zoho-oauthtoken %s", os.Getenv("AUTH_ACCESS_TOKEN"))
-}
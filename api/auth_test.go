@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"site24x7/config"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// mockAuthServer returns a server that issues access tokens and counts how
+// many times it's been asked to
+func mockAuthServer(t *testing.T, exchanges *int) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*exchanges++
+		fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600, "token_type": "Bearer"}`, *exchanges)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// setupAuth isolates the token cache and points authentication at a server
+func setupAuth(t *testing.T, authURL string) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("AUTH_BASE_URL", authURL)
+	profileTokens.token = ""
+
+	viper.Reset()
+	config.SetProfile("")
+	config.Set("auth.client_id", "client")
+	config.Set("auth.refresh_token", "refresh")
+}
+
+func TestAuthenticate(t *testing.T) {
+	var exchanges int
+	srv := mockAuthServer(t, &exchanges)
+	setupAuth(t, srv.URL)
+
+	if err := Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if err := Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if exchanges != 1 {
+		t.Errorf("Authenticate() exchanged the refresh token %d times, want 1", exchanges)
+	}
+	if got := profileTokens.token; got != "token-1" {
+		t.Errorf("Authenticate() stored %s, want token-1", got)
+	}
+
+	// Reconfiguring the profile invalidates the cached token
+	config.Set("auth.refresh_token", "another refresh")
+	if err := Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if exchanges != 2 {
+		t.Errorf("Authenticate() exchanged the refresh token %d times, want 2", exchanges)
+	}
+}
+
+func Test_cachedToken_valid(t *testing.T) {
+	setupAuth(t, "http://localhost")
+
+	tests := []struct {
+		name  string
+		token cachedToken
+		want  bool
+	}{
+		{
+			name: "Accepts an unexpired token",
+			token: cachedToken{
+				AuthToken:   AuthToken{AccessToken: "token"},
+				ExpiresAt:   time.Now().Add(time.Hour),
+				Fingerprint: credentialsFingerprint(),
+			},
+			want: true,
+		},
+		{
+			name: "Rejects a token that's about to expire",
+			token: cachedToken{
+				AuthToken:   AuthToken{AccessToken: "token"},
+				ExpiresAt:   time.Now().Add(time.Minute),
+				Fingerprint: credentialsFingerprint(),
+			},
+			want: false,
+		},
+		{
+			name: "Rejects a token issued for other credentials",
+			token: cachedToken{
+				AuthToken:   AuthToken{AccessToken: "token"},
+				ExpiresAt:   time.Now().Add(time.Hour),
+				Fingerprint: "something else",
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.token.valid(); got != tt.want {
+				t.Errorf("cachedToken.valid() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequest_Fetch(t *testing.T) {
+	var exchanges int
+	authSrv := mockAuthServer(t, &exchanges)
+	setupAuth(t, authSrv.URL)
+
+	// The API only accepts the second token issued
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Zoho-oauthtoken token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"code": 401, "message": "Unauthorized"}`)
+			return
+		}
+		fmt.Fprint(w, `{"code": 0, "message": "success", "data": {}}`)
+	}))
+	t.Cleanup(apiSrv.Close)
+
+	if err := Authenticate(context.Background()); err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+
+	req := Request{
+		Endpoint: apiSrv.URL,
+		Method:   "GET",
+		Headers:  http.Header{},
+	}
+	res, err := req.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if res.Message != "success" {
+		t.Errorf("Fetch() message = %s, want success", res.Message)
+	}
+	if exchanges != 2 {
+		t.Errorf("Fetch() exchanged the refresh token %d times, want 2", exchanges)
+	}
+}
@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"site24x7/logger"
+	"strconv"
+	"time"
+)
+
+// DefaultTimeout is the time allowed for each attempt at a request
+const DefaultTimeout = 30 * time.Second
+
+// DefaultRetries is the number of times a failed request is retried
+const DefaultRetries = 3
+
+// The bounds of the delay between attempts at a request
+const (
+	minBackoff = 500 * time.Millisecond
+	maxBackoff = 30 * time.Second
+)
+
+// Client accesses the Site24x7 API on behalf of a single account. Clients
+// don't depend on the CLI's configuration, or on each other, so a program can
+// use as many as it needs, e.g.
+//
+//	dc, _ := api.LookupDataCenter("EU")
+//	tokens := api.NewRefreshTokenSource(dc.AuthBaseURL, api.Credentials{...}, nil)
+//	users, err := api.NewClient(dc.APIBaseURL, tokens, nil).Users(ctx)
+type Client struct {
+	APIBaseURL  string       // e.g. https://www.site24x7.com/api
+	HTTPClient  *http.Client // executes every request
+	TokenSource TokenSource  // authenticates every request
+	Retries     int          // times a throttled or transiently failed request is retried
+	DryRun      bool         // when true, writes are reported rather than sent
+}
+
+// NewClient returns a client for the API at a base URL, usually that of a
+// DataCenter. A nil http.Client is replaced by one with the default timeout.
+func NewClient(apiBaseURL string, ts TokenSource, hc *http.Client) *Client {
+	if hc == nil {
+		hc = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	return &Client{
+		APIBaseURL:  apiBaseURL,
+		HTTPClient:  hc,
+		TokenSource: ts,
+		Retries:     DefaultRetries,
+	}
+}
+
+// The settings of the client used by the package-level functions, i.e. by the
+// CLI, which are configured once per command
+var (
+	httpClient = &http.Client{Timeout: DefaultTimeout}
+	retries    = DefaultRetries
+)
+
+// defaultClient returns a client for the account of the current configuration
+// profile
+func defaultClient() *Client {
+	return &Client{
+		APIBaseURL:  apiBaseURL(),
+		HTTPClient:  httpClient,
+		TokenSource: profileTokens,
+		Retries:     retries,
+		DryRun:      dryRun,
+	}
+}
+
+// wait pauses between attempts, returning early with the context's error if
+// it's cancelled; aliased for testing
+var wait = func(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// SetHTTPClient replaces the client that executes requests, e.g. to route them
+// through a proxy or a test server. The client's timeout is kept as is.
+func SetHTTPClient(c *http.Client) {
+	httpClient = c
+}
+
+// SetTimeout sets the time allowed for each attempt at a request; zero means
+// no limit
+func SetTimeout(d time.Duration) {
+	httpClient.Timeout = d
+}
+
+// SetRetries sets the number of times that a failed request is retried; zero
+// means that each request is attempted only once
+func SetRetries(n int) {
+	if n < 0 {
+		n = 0
+	}
+	retries = n
+}
+
+// decode unmarshals response data, e.g. decode[*User](res.Data)
+func decode[T any](data json.RawMessage) (T, error) {
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("[api.decode] Unable to  parse response data (%s)", err)
+	}
+
+	return v, nil
+}
+
+// encode adapts the result of a Client method for the package-level functions,
+// which return json
+func encode[T any](v T, err error) (json.RawMessage, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(v)
+}
+
+// idempotent reports whether a request can be repeated without changing its
+// effect, and so be safely retried when its outcome is unknown
+func idempotent(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+
+	return false
+}
+
+// retryable reports whether an attempt at a request should be retried. A
+// throttled (429) request wasn't processed, so it's always safe to retry; a
+// request that failed in transit or with a server error (5xx) is only retried
+// if it's idempotent.
+func retryable(method string, status int, err error) bool {
+	if status == http.StatusTooManyRequests {
+		return true
+	}
+
+	return idempotent(method) && (err != nil || status >= 500)
+}
+
+// backoff returns the delay before a retry: the server's Retry-After, when
+// given, or an exponentially increasing delay with full jitter. Neither is
+// longer than maxBackoff, so a server that asks for an hour can't stall a
+// command for one.
+func backoff(attempt int, h http.Header) time.Duration {
+	if d, ok := retryAfter(h); ok {
+		if d > maxBackoff {
+			d = maxBackoff
+		}
+		return d
+	}
+
+	d := minBackoff << attempt
+	if d <= 0 || d > maxBackoff {
+		d = maxBackoff
+	}
+
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryAfter parses a Retry-After header, which holds either a number of
+// seconds or an HTTP date
+func retryAfter(h http.Header) (time.Duration, bool) {
+	v := h.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if s, err := strconv.Atoi(v); err == nil && s >= 0 {
+		return time.Duration(s) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+// retry logs, and waits out, the delay before another attempt at a request
+func (r *Request) retry(ctx context.Context, attempt int, status int, h http.Header, err error) error {
+	d := backoff(attempt, h)
+
+	reason := http.StatusText(status)
+	if err != nil {
+		reason = err.Error()
+	}
+	logger.Info(fmt.Sprintf("[api.Fetch] %s %s failed (%s); retrying in %s (%d of %d)", r.Method, r.Endpoint, reason, d.Round(time.Millisecond), attempt+1, r.client.Retries))
+
+	return wait(ctx, d)
+}
@@ -0,0 +1,301 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRequest_Fetch_retries(t *testing.T) {
+	var slept []time.Duration
+	defer func(fn func(context.Context, time.Duration) error) { wait = fn }(wait)
+	wait = func(ctx context.Context, d time.Duration) error {
+		slept = append(slept, d)
+		return nil
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		statuses     []int // returned by successive attempts; then 200
+		retryAfter   string
+		wantRequests int
+		wantErr      bool
+		wantSlept    []time.Duration
+	}{
+		{
+			name:         "Succeeds without retrying",
+			method:       "GET",
+			wantRequests: 1,
+		},
+		{
+			name:         "Retries a server error",
+			method:       "GET",
+			statuses:     []int{http.StatusBadGateway, http.StatusServiceUnavailable},
+			wantRequests: 3,
+		},
+		{
+			name:         "Gives up after the retries are spent",
+			method:       "DELETE",
+			statuses:     []int{500, 500, 500, 500, 500},
+			wantRequests: 4,
+			wantErr:      true,
+		},
+		{
+			name:         "Doesn't retry a server error for a POST",
+			method:       "POST",
+			statuses:     []int{http.StatusInternalServerError},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+		{
+			name:         "Retries a throttled POST after Retry-After",
+			method:       "POST",
+			statuses:     []int{http.StatusTooManyRequests},
+			retryAfter:   "7",
+			wantRequests: 2,
+			wantSlept:    []time.Duration{7 * time.Second},
+		},
+		{
+			name:         "Doesn't retry a client error",
+			method:       "GET",
+			statuses:     []int{http.StatusBadRequest},
+			wantRequests: 1,
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slept = nil
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if requests <= len(tt.statuses) {
+					if tt.retryAfter != "" {
+						w.Header().Set("Retry-After", tt.retryAfter)
+					}
+					w.WriteHeader(tt.statuses[requests-1])
+					fmt.Fprint(w, `{"code": 1, "message": "failure"}`)
+					return
+				}
+				fmt.Fprint(w, `{"code": 0, "message": "success"}`)
+			}))
+			t.Cleanup(srv.Close)
+
+			req := Request{Endpoint: srv.URL, Method: tt.method, Headers: http.Header{}}
+			if _, err := req.Fetch(context.Background()); (err != nil) != tt.wantErr {
+				t.Errorf("Fetch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if requests != tt.wantRequests {
+				t.Errorf("Fetch() sent %d requests, want %d", requests, tt.wantRequests)
+			}
+			if tt.wantSlept != nil && !reflect.DeepEqual(slept, tt.wantSlept) {
+				t.Errorf("Fetch() slept %v, want %v", slept, tt.wantSlept)
+			}
+		})
+	}
+}
+
+func TestRequest_Fetch_timeout(t *testing.T) {
+	defer func(fn func(context.Context, time.Duration) error) { wait = fn }(wait)
+	wait = func(context.Context, time.Duration) error { return nil }
+	SetTimeout(50 * time.Millisecond)
+	t.Cleanup(func() { SetTimeout(DefaultTimeout) })
+	SetRetries(1)
+	t.Cleanup(func() { SetRetries(DefaultRetries) })
+
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		time.Sleep(200 * time.Millisecond)
+	}))
+	t.Cleanup(srv.Close)
+
+	req := Request{Endpoint: srv.URL, Method: "GET", Headers: http.Header{}}
+	if _, err := req.Fetch(context.Background()); err == nil {
+		t.Errorf("Fetch() error = nil, want a timeout")
+	}
+	if requests != 2 {
+		t.Errorf("Fetch() sent %d requests, want 2", requests)
+	}
+}
+
+func TestRequest_Fetch_cancel(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+	}{
+		{
+			name: "Abandons a request in flight",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				select {
+				case <-r.Context().Done():
+				case <-time.After(5 * time.Second):
+				}
+			},
+		},
+		{
+			name: "Abandons the wait for a retry",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "60")
+				w.WriteHeader(http.StatusTooManyRequests)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				tt.handler(w, r)
+			}))
+			t.Cleanup(srv.Close)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			time.AfterFunc(50*time.Millisecond, cancel)
+
+			start := time.Now()
+			req := Request{Endpoint: srv.URL, Method: "GET", Headers: http.Header{}}
+			if _, err := req.Fetch(ctx); !errors.Is(err, context.Canceled) {
+				t.Errorf("Fetch() error = %v, want %v", err, context.Canceled)
+			}
+			if requests != 1 {
+				t.Errorf("Fetch() sent %d requests, want 1", requests)
+			}
+			if elapsed := time.Since(start); elapsed > time.Second {
+				t.Errorf("Fetch() returned after %s, want it to return when cancelled", elapsed)
+			}
+		})
+	}
+}
+
+func TestRequest_Fetch_queryString(t *testing.T) {
+	var query string
+	var body int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, body = r.URL.RawQuery, r.ContentLength
+		fmt.Fprint(w, `{"code": 0, "message": "success"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	req := Request{Endpoint: srv.URL, Method: "GET", Headers: http.Header{}, QueryString: map[string][]string{"subgroup_required": {"true"}}}
+	if _, err := req.Fetch(context.Background()); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if query != "subgroup_required=true" || body != 0 {
+		t.Errorf("Fetch() sent query %q and a %d byte body, want the query string in the URL", query, body)
+	}
+}
+
+func Test_backoff(t *testing.T) {
+	for attempt := 0; attempt < 10; attempt++ {
+		d := backoff(attempt, http.Header{})
+		ceiling := minBackoff << attempt
+		if ceiling > maxBackoff {
+			ceiling = maxBackoff
+		}
+		if d < ceiling/2 || d > ceiling {
+			t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, d, ceiling/2, ceiling)
+		}
+	}
+
+	date := time.Now().Add(20 * time.Second).UTC().Format(http.TimeFormat)
+	if d := backoff(0, http.Header{"Retry-After": {date}}); d < 18*time.Second || d > 20*time.Second {
+		t.Errorf("backoff() = %s for Retry-After %s, want about 20s", d, date)
+	}
+
+	if d := backoff(0, http.Header{"Retry-After": {"3600"}}); d != maxBackoff {
+		t.Errorf("backoff() = %s for Retry-After 3600, want %s", d, maxBackoff)
+	}
+}
+
+func TestClient(t *testing.T) {
+	// Each account's server only accepts its own token
+	account := func(token string, users string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Zoho-oauthtoken "+token {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"code": 401, "message": "Unauthorized"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"code": 0, "message": "success", "data": %s}`, users)
+		}))
+		t.Cleanup(srv.Close)
+
+		return srv
+	}
+	us := account("us-token", `[{"user_id": "1", "email_address": "fred@example.com"}]`)
+	eu := account("eu-token", `[{"user_id": "2", "email_address": "wilma@example.eu"}]`)
+
+	// Two accounts in one process, independent of the CLI's configuration
+	usClient := NewClient(us.URL, StaticToken("us-token"), nil)
+	euClient := NewClient(eu.URL, StaticToken("eu-token"), nil)
+
+	users, err := usClient.Users(context.Background())
+	if err != nil {
+		t.Fatalf("Client.Users() error = %v", err)
+	}
+	if want := []User{{ID: "1", EmailAddress: "fred@example.com"}}; !reflect.DeepEqual(users, want) {
+		t.Errorf("Client.Users() = %+v, want %+v", users, want)
+	}
+
+	users, err = euClient.Users(context.Background())
+	if err != nil {
+		t.Fatalf("Client.Users() error = %v", err)
+	}
+	if want := []User{{ID: "2", EmailAddress: "wilma@example.eu"}}; !reflect.DeepEqual(users, want) {
+		t.Errorf("Client.Users() = %+v, want %+v", users, want)
+	}
+
+	// A static token can't be refreshed when it's rejected
+	_, err = NewClient(us.URL, StaticToken("eu-token"), nil).Users(context.Background())
+	if _, ok := err.(*UnauthorizedError); !ok {
+		t.Errorf("Client.Users() error = %v, want an UnauthorizedError", err)
+	}
+}
+
+func TestRefreshTokenSource(t *testing.T) {
+	var exchanges int
+	authSrv := mockAuthServer(t, &exchanges)
+	ts := NewRefreshTokenSource(authSrv.URL, Credentials{ClientID: "client", RefreshToken: "refresh"}, nil)
+
+	for i := 0; i < 2; i++ {
+		token, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("RefreshTokenSource.Token() error = %v", err)
+		}
+		if token != "token-1" {
+			t.Errorf("RefreshTokenSource.Token() = %s, want token-1", token)
+		}
+	}
+	if exchanges != 1 {
+		t.Errorf("RefreshTokenSource.Token() exchanged the refresh token %d times, want 1", exchanges)
+	}
+
+	// The API only accepts the second token issued, so the first is refreshed
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Zoho-oauthtoken token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"code": 401, "message": "Unauthorized"}`)
+			return
+		}
+		fmt.Fprint(w, `{"code": 0, "message": "success", "data": {"user_group_id": "7", "display_name": "Ops"}}`)
+	}))
+	t.Cleanup(apiSrv.Close)
+
+	ug, err := NewClient(apiSrv.URL, ts, nil).UserGroup(context.Background(), "7")
+	if err != nil {
+		t.Fatalf("Client.UserGroup() error = %v", err)
+	}
+	if ug.ID != "7" || ug.Name != "Ops" {
+		t.Errorf("Client.UserGroup() = %+v, want group 7", ug)
+	}
+	if exchanges != 2 {
+		t.Errorf("Client.UserGroup() exchanged the refresh token %d times, want 2", exchanges)
+	}
+}
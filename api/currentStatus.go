@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MonitorStatus is the current status of a monitor
+type MonitorStatus struct {
+	ID             string `json:"monitor_id"`
+	Name           string `json:"name"`
+	Type           string `json:"monitor_type"`
+	Status         int    `json:"status"` // https://www.site24x7.com/help/api/#status_constants
+	LastPolledTime string `json:"last_polled_time,omitempty"`
+	DownReason     string `json:"down_reason,omitempty"`
+	Duration       string `json:"duration,omitempty"` // how long the monitor has had its status
+}
+
+// MonitorGroupStatus is the current status of a monitor group and its monitors
+type MonitorGroupStatus struct {
+	ID       string          `json:"group_id"`
+	Name     string          `json:"group_name"`
+	Status   int             `json:"status"`
+	Monitors []MonitorStatus `json:"monitors"`
+}
+
+// CurrentStatus contains the data returned from a request for the current
+// status of every monitor. Monitors that belong to a monitor group are listed
+// with it; others are listed on their own.
+type CurrentStatus struct {
+	Monitors      []MonitorStatus      `json:"monitors"`
+	MonitorGroups []MonitorGroupStatus `json:"monitor_groups"`
+}
+
+// CurrentStatus returns the current status of every monitor, grouped by
+// monitor group
+// https://www.site24x7.com/help/api/#current-status-of-all-monitors
+func (c *Client) CurrentStatus(ctx context.Context) (*CurrentStatus, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/current_status", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body: nil,
+		QueryString: url.Values{
+			"group_required": {"true"},
+		},
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving current status; message: %s", res.Message)
+	}
+
+	return decode[*CurrentStatus](res.Data)
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// CurrentStatusGet returns Client.CurrentStatus as json for the current profile
+func CurrentStatusGet(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().CurrentStatus(ctx))
+}
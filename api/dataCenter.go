@@ -0,0 +1,98 @@
+package api
+
+import (
+	"fmt"
+	"os"
+	"site24x7/config"
+	"sort"
+	"strings"
+)
+
+// DefaultDataCenter is the data center used when none has been configured
+const DefaultDataCenter = "US"
+
+// DataCenter identifies the hosts that serve a Site24x7 account. Each Site24x7
+// data center has its own API host and its own Zoho accounts host, and an
+// account (along with its OAuth credentials) only exists in one of them.
+// https://www.site24x7.com/help/api/#introduction
+type DataCenter struct {
+	Code        string
+	Name        string
+	APIBaseURL  string
+	AuthBaseURL string
+}
+
+// DataCenters maps each supported data center code to its hosts
+var DataCenters = map[string]DataCenter{
+	"US": {"US", "United States", "https://www.site24x7.com/api", "https://accounts.zoho.com"},
+	"EU": {"EU", "Europe", "https://www.site24x7.eu/api", "https://accounts.zoho.eu"},
+	"IN": {"IN", "India", "https://www.site24x7.in/api", "https://accounts.zoho.in"},
+	"AU": {"AU", "Australia", "https://www.site24x7.net.au/api", "https://accounts.zoho.com.au"},
+	"CN": {"CN", "China", "https://www.site24x7.cn/api", "https://accounts.zoho.com.cn"},
+	"JP": {"JP", "Japan", "https://www.site24x7.jp/api", "https://accounts.zoho.jp"},
+	"CA": {"CA", "Canada", "https://www.site24x7.ca/api", "https://accounts.zohocloud.ca"},
+}
+
+// DataCenterCodes returns the sorted list of supported data center codes
+func DataCenterCodes() []string {
+	codes := make([]string, 0, len(DataCenters))
+	for c := range DataCenters {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	return codes
+}
+
+// LookupDataCenter returns the data center identified by a (case insensitive)
+// code. An empty code resolves to the default data center.
+func LookupDataCenter(code string) (*DataCenter, error) {
+	if code == "" {
+		code = DefaultDataCenter
+	}
+
+	dc, ok := DataCenters[strings.ToUpper(code)]
+	if !ok {
+		return nil, fmt.Errorf("unknown data center (%s); expected one of %s", code, strings.Join(DataCenterCodes(), ", "))
+	}
+
+	return &dc, nil
+}
+
+// CurrentDataCenter returns the data center identified by the current profile's
+// auth.data_center configuration value.
+func CurrentDataCenter() (*DataCenter, error) {
+	return LookupDataCenter(config.GetString("auth.data_center"))
+}
+
+// apiBaseURL returns the base URL of the Site24x7 API for the configured data
+// center. The API_BASE_URL environment variable takes precedence, which is
+// useful for development.
+func apiBaseURL() string {
+	if u := os.Getenv("API_BASE_URL"); u != "" {
+		return u
+	}
+
+	dc, err := CurrentDataCenter()
+	if err != nil {
+		dc, _ = LookupDataCenter(DefaultDataCenter)
+	}
+
+	return dc.APIBaseURL
+}
+
+// authBaseURL returns the base URL of the Zoho accounts server for the
+// configured data center. The AUTH_BASE_URL environment variable takes
+// precedence, which is useful for development.
+func authBaseURL() string {
+	if u := os.Getenv("AUTH_BASE_URL"); u != "" {
+		return u
+	}
+
+	dc, err := CurrentDataCenter()
+	if err != nil {
+		dc, _ = LookupDataCenter(DefaultDataCenter)
+	}
+
+	return dc.AuthBaseURL
+}
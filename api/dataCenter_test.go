@@ -0,0 +1,59 @@
+package api
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLookupDataCenter(t *testing.T) {
+	type args struct {
+		code string
+	}
+
+	us := DataCenters["US"]
+	eu := DataCenters["EU"]
+
+	tests := []struct {
+		name       string
+		args       args
+		want       *DataCenter
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name:    "Defaults to the US data center",
+			args:    args{code: ""},
+			want:    &us,
+			wantErr: false,
+		},
+		{
+			name:    "Matches a code regardless of case",
+			args:    args{code: "eu"},
+			want:    &eu,
+			wantErr: false,
+		},
+		{
+			name:       "Rejects an unknown code",
+			args:       args{code: "MARS"},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "unknown data center (MARS)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LookupDataCenter(tt.args.code)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("LookupDataCenter() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("LookupDataCenter() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LookupDataCenter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"site24x7/logger"
+	"strings"
+)
+
+// dryRun stops requests that would write to the account from being sent
+var dryRun bool
+
+// SetDryRun turns dry run mode on or off. In dry run mode, any request that
+// would write to the account is reported rather than sent, and succeeds as if
+// the API had echoed back the request body.
+func SetDryRun(v bool) {
+	dryRun = v
+}
+
+// DryRun reports whether dry run mode is on
+func DryRun() bool {
+	return dryRun
+}
+
+// simulate stands in for the API when a write request isn't sent
+func (r *Request) simulate() *APIResponse {
+	logger.Warn(fmt.Sprintf("Dry run; not sending %s %s", r.Method, r.Endpoint))
+	logger.Info(fmt.Sprintf("[api.simulate] Request body: %s", r.Body))
+
+	var data json.RawMessage
+	if len(r.Body) > 0 {
+		data = r.withID(r.Body)
+	}
+
+	return &APIResponse{Message: "success", Data: data}
+}
+
+// idProperties names the property that holds the ID of each kind of object
+// that's updated at <kind>/<id>
+var idProperties = map[string]string{
+	"users":                 "user_id",
+	"user_groups":           "user_group_id",
+	"monitor_groups":        "group_id",
+	"monitors":              "monitor_id",
+	"threshold_profiles":    "profile_id",
+	"notification_profiles": "profile_id",
+	"location_profiles":     "profile_id",
+	"maintenance":           "maintenance_id",
+	"tags":                  "tag_id",
+}
+
+// withID adds the ID of the object that a request updates, which is given in
+// its endpoint rather than its body, to an echoed body, so that a dry run
+// shows the object as the API would have returned it
+func (r *Request) withID(body []byte) []byte {
+	u, err := url.Parse(r.Endpoint)
+	if err != nil || r.Method != "PUT" {
+		return body
+	}
+	segments := strings.Split(strings.TrimSuffix(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return body
+	}
+	property, ok := idProperties[segments[len(segments)-2]]
+	if !ok {
+		return body
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body, &object); err != nil {
+		return body
+	}
+	if _, ok := object[property]; !ok {
+		object[property], _ = json.Marshal(segments[len(segments)-1])
+	}
+	b, _ := json.Marshal(object)
+
+	return b
+}
+
+// writable is implemented by each type that can be written to the API
+type writable interface {
+	toRequestBody() []byte
+}
+
+// RequestBody returns the body of the request that would write an object,
+// e.g. an *api.User, to the API; read-only properties aren't included.
+func RequestBody(v interface{}) (json.RawMessage, error) {
+	w, ok := v.(writable)
+	if !ok {
+		return nil, fmt.Errorf("[api.RequestBody] Unable to write a %T", v)
+	}
+
+	return w.toRequestBody(), nil
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequest_Fetch_dryRun(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"code": 0, "message": "success", "data": {"user_group_id": "1"}}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	SetDryRun(true)
+	t.Cleanup(func() { SetDryRun(false) })
+
+	// Writes aren't sent, but succeed with the request body...
+	req := Request{Endpoint: srv.URL, Method: "PUT", Headers: http.Header{}, Body: []byte(`{"display_name":"Ops"}`)}
+	res, err := req.Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if res.Message != "success" || string(res.Data) != `{"display_name":"Ops"}` {
+		t.Errorf("Fetch() = %+v, want the request body", res)
+	}
+	if requests != 0 {
+		t.Errorf("Fetch() sent %d requests, want 0", requests)
+	}
+
+	// ...while reads are
+	req = Request{Endpoint: srv.URL, Method: "GET", Headers: http.Header{}}
+	if _, err := req.Fetch(context.Background()); err != nil {
+		t.Fatalf("Fetch() error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Fetch() sent %d requests, want 1", requests)
+	}
+}
+
+func TestRequest_simulate(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		method   string
+		body     string
+		want     string
+	}{
+		{
+			name:     "Adds the ID of an updated user",
+			endpoint: "https://example.com/api/users/1001",
+			method:   "PUT",
+			body:     `{"display_name":"Fred"}`,
+			want:     `{"display_name":"Fred","user_id":"1001"}`,
+		},
+		{
+			name:     "Adds the ID of an updated monitor group",
+			endpoint: "https://example.com/api/monitor_groups/2002",
+			method:   "PUT",
+			body:     `{"display_name":"Production"}`,
+			want:     `{"display_name":"Production","group_id":"2002"}`,
+		},
+		{
+			name:     "Leaves a created object without an ID",
+			endpoint: "https://example.com/api/monitor_groups",
+			method:   "POST",
+			body:     `{"display_name":"Production"}`,
+			want:     `{"display_name":"Production"}`,
+		},
+		{
+			name:     "Leaves a request to an unknown kind of object alone",
+			endpoint: "https://example.com/api/monitors/activate/3003",
+			method:   "PUT",
+			body:     `{}`,
+			want:     `{}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Request{Endpoint: tt.endpoint, Method: tt.method, Body: []byte(tt.body)}
+			if got := r.simulate(); string(got.Data) != tt.want {
+				t.Errorf("simulate() = %s, want %s", got.Data, tt.want)
+			}
+		})
+	}
+}
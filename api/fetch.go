@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -20,12 +21,15 @@ type Request struct {
 	Body        []byte
 	Headers     http.Header
 	QueryString url.Values
+
+	client *Client // makes the request; the CLI's client if nil
 }
 
 // APIResponse defines the top level schema of (almost?) every Site24x7 API
 // response. The Data component contains the domain model data and varies wildly
 // between API calls, so it must be flexible. We'll handle it as raw JSON at
-// this stage and unmarshal it separately when we need it.
+// this stage and unmarshal it separately when we need it. Data is nil when a
+// response carries none, as with an EmptyAPIResponse.
 type APIResponse struct {
 	Code    int             `json:"code"`
 	Message string          `json:"message"`
@@ -40,6 +44,9 @@ type EmptyAPIResponse struct {
 	Message string `json:"message"`
 }
 
+// emptySuccess is the body of a successful response that includes no data
+var emptySuccess, _ = json.Marshal(EmptyAPIResponse{Message: "success"})
+
 // FetchAuthToken returns a refresh token, an access token, or both depending on
 // whether a grant token or a refresh token is being exchanged.
 //
@@ -50,11 +57,11 @@ type EmptyAPIResponse struct {
 //
 // This function is called before any command is executed and cannot reliably
 // use the logger.
-func (r *Request) FetchAuthToken() (*AuthToken, error) {
+func (r *Request) FetchAuthToken(ctx context.Context) (*AuthToken, error) {
 	// Weirdness #1: serialize the query string data so it can be sent as the
 	// request body. ¯\_(ツ)_/¯
 	body := strings.NewReader(r.QueryString.Encode())
-	req, err := http.NewRequest(r.Method, r.Endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, r.Method, r.Endpoint, body)
 	if err != nil {
 		return nil, fmt.Errorf("[api.FetchAuthToken] ERROR: Unable to create request (%s)", err)
 	}
@@ -65,8 +72,16 @@ func (r *Request) FetchAuthToken() (*AuthToken, error) {
 		logger.Debug(fmt.Sprintf("[api.FetchAuthToken] Request: %q\n", dumpreq))
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	hc := httpClient
+	if r.client != nil && r.client.HTTPClient != nil {
+		hc = r.client.HTTPClient
+	}
+
+	res, err := hc.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
 		return nil, fmt.Errorf("[api.FetchAuthToken] ERROR: unable to execute request (%s)", err)
 	}
 	defer res.Body.Close()
@@ -91,16 +106,114 @@ func (r *Request) FetchAuthToken() (*AuthToken, error) {
 	return &t, nil
 }
 
-// Fetch calls a Site24x7 API and returns the response.
-func (r *Request) Fetch() (*APIResponse, error) {
-	body := bytes.NewReader(r.Body)
-	qs := strings.NewReader(r.QueryString.Encode())
+// Fetch calls a Site24x7 API and returns the response. An access token can be
+// revoked or expire ahead of schedule, so a request that's rejected as
+// unauthorized is retried once with a fresh token. Cancelling the context
+// abandons the request, and any retries, with the context's error.
+func (r *Request) Fetch(ctx context.Context) (*APIResponse, error) {
+	if r.client == nil {
+		r.client = defaultClient()
+	}
+	c := r.client
+
+	if c.DryRun && r.Method != "GET" {
+		return r.simulate(), nil
+	}
+
+	var token string
+	if c.TokenSource != nil {
+		var err error
+		if token, err = c.TokenSource.Token(ctx); err != nil {
+			return nil, err
+		}
+		r.authorize(token)
+	}
+
+	status, h, b, err := r.do(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if status == http.StatusUnauthorized && token != "" {
+		logger.Info("[api.Fetch] Access token rejected; exchanging the refresh token for a new one")
+		if token, err = c.TokenSource.Refresh(ctx); err != nil {
+			return nil, err
+		}
+
+		r.authorize(token)
+		if status, h, b, err = r.do(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// Some endpoints succeed without any body at all, which is as good as an
+	// EmptyAPIResponse
+	if status < 400 && len(bytes.TrimSpace(b)) == 0 {
+		b = emptySuccess
+	}
+
+	var ar APIResponse
+	if err := json.Unmarshal(b, &ar); err != nil {
+		if status >= 400 {
+			return nil, newError(status, h, nil)
+		}
+		return nil, fmt.Errorf("[api.Fetch] ERROR: Unable to  parse response body (%s)", err)
+	}
 
-	var req *http.Request
-	if r.Method == "GET" {
-		req, _ = http.NewRequest(r.Method, r.Endpoint, qs)
-	} else {
-		req, _ = http.NewRequest(r.Method, r.Endpoint, body)
+	// Site24x7 reports success with a code of 0
+	if status >= 400 || ar.Code != 0 {
+		logger.Debug(fmt.Sprintf("[api.Fetch] Response\n%+v", ar))
+		return nil, newError(status, h, &ar)
+	}
+
+	return &ar, nil
+}
+
+// authorize sets the header that authenticates a request
+func (r *Request) authorize(token string) {
+	if token == "" {
+		return
+	}
+	if r.Headers == nil {
+		r.Headers = http.Header{}
+	}
+	r.Headers.Set("Authorization", fmt.Sprintf("Zoho-oauthtoken %s", token))
+}
+
+// do executes a request, retrying any attempt that fails transiently, and
+// returns the response status, headers and body
+func (r *Request) do(ctx context.Context) (int, http.Header, []byte, error) {
+	for attempt := 0; ; attempt++ {
+		status, h, b, err := r.send(ctx)
+		if ctx.Err() != nil {
+			return 0, nil, nil, ctx.Err()
+		}
+		if attempt < r.client.Retries && retryable(r.Method, status, err) {
+			if err := r.retry(ctx, attempt, status, h, err); err != nil {
+				return 0, nil, nil, err
+			}
+			continue
+		}
+
+		return status, h, b, err
+	}
+}
+
+// send makes a single attempt at a request and returns the response status,
+// headers and body
+func (r *Request) send(ctx context.Context) (int, http.Header, []byte, error) {
+	endpoint := r.Endpoint
+	if len(r.QueryString) > 0 {
+		sep := "?"
+		if strings.Contains(endpoint, "?") {
+			sep = "&"
+		}
+		endpoint += sep + r.QueryString.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, r.Method, endpoint, bytes.NewReader(r.Body))
+	if err != nil {
+		return 0, nil, nil, fmt.Errorf("[api.Fetch] ERROR: Unable to create request (%s)", err)
 	}
 	req.Header = r.Headers
 
@@ -112,9 +225,9 @@ func (r *Request) Fetch() (*APIResponse, error) {
 		logger.Debug(fmt.Sprintf("[api.Fetch] Request: %q\n", dumpreq))
 	}
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := r.client.HTTPClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("[api.Fetch] ERROR: unable to execute request (%s)", err)
+		return 0, nil, nil, fmt.Errorf("[api.Fetch] ERROR: unable to execute request (%s)", err)
 	}
 	defer res.Body.Close()
 
@@ -125,13 +238,8 @@ func (r *Request) Fetch() (*APIResponse, error) {
 
 	b, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, fmt.Errorf("[api.Fetch] ERROR: Unable to read response body (%s)", err)
-	}
-
-	var ar APIResponse
-	if err := json.Unmarshal(b, &ar); err != nil {
-		return nil, fmt.Errorf("[api.Fetch] ERROR: Unable to  parse response body (%s)", err)
+		return 0, nil, nil, fmt.Errorf("[api.Fetch] ERROR: Unable to read response body (%s)", err)
 	}
 
-	return &ar, nil
+	return res.StatusCode, res.Header, b, nil
 }
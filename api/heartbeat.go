@@ -0,0 +1,52 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+	"strings"
+)
+
+// Signals that a heartbeat ping can send; a plain ping reports success
+const (
+	HeartbeatSuccess = ""
+	HeartbeatStart   = "start"
+	HeartbeatFail    = "fail"
+)
+
+// HeartbeatPing signals a heartbeat monitor at its ping URL. Unlike the rest
+// of the API, the ping URL takes no access token; a signal other than success
+// is appended to its path.
+func HeartbeatPing(ctx context.Context, pingURL string, signal string) error {
+	endpoint := pingURL
+	if signal != HeartbeatSuccess {
+		endpoint = strings.TrimSuffix(pingURL, "/") + "/" + signal
+	}
+
+	if dryRun {
+		logger.Warn(fmt.Sprintf("Dry run; not sending GET %s", endpoint))
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("[api.HeartbeatPing] ERROR: Unable to create request (%s)", err)
+	}
+
+	logger.Info(fmt.Sprintf("[api.HeartbeatPing] Pinging %s", endpoint))
+	res, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return fmt.Errorf("[api.HeartbeatPing] ERROR: unable to execute request (%s)", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		return fmt.Errorf("heartbeat ping rejected with HTTP status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Location is a Site24x7 polling location
+type Location struct {
+	ID        string `json:"location_id"`
+	Name      string `json:"display_name"`
+	City      string `json:"city,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Continent string `json:"continent,omitempty"`
+	UseIPv6   bool   `json:"use_ipv6,omitempty"`
+}
+
+// Locations returns all of the available polling locations
+// https://www.site24x7.com/help/api/#location-template
+func (c *Client) Locations(ctx context.Context) ([]Location, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_template", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving locations; message: %s", res.Message)
+	}
+
+	// The locations are wrapped in a template object
+	var template struct {
+		Locations json.RawMessage `json:"locations"`
+	}
+	if err := json.Unmarshal(res.Data, &template); err != nil || template.Locations == nil {
+		return nil, fmt.Errorf("[api.Locations] Unable to parse locations from response")
+	}
+
+	return decode[[]Location](template.Locations)
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// LocationList returns Client.Locations as json for the current profile
+func LocationList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().Locations(ctx))
+}
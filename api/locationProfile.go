@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// LocationProfile contains the data returned from any request for location
+// profile information. A location profile determines the locations from which
+// a monitor is polled.
+type LocationProfile struct {
+	ID                               string   `json:"profile_id"`
+	Name                             string   `json:"profile_name"`
+	PrimaryLocation                  string   `json:"primary_location"`
+	SecondaryLocations               []string `json:"secondary_locations,omitempty"`
+	RestrictAlternateLocationPolling bool     `json:"restrict_alternate_location_polling"`
+}
+
+// LocationProfileRequestBody defines the HTTP request body structure
+type LocationProfileRequestBody struct {
+	Name                             string   `json:"profile_name"`
+	PrimaryLocation                  string   `json:"primary_location"`
+	SecondaryLocations               []string `json:"secondary_locations,omitempty"`
+	RestrictAlternateLocationPolling bool     `json:"restrict_alternate_location_polling"`
+}
+
+// toRequestBody performs a struct conversion
+func (lp *LocationProfile) toRequestBody() []byte {
+	var b LocationProfileRequestBody
+	tmp, _ := json.Marshal(lp)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// LocationProfiles returns all location profiles
+// https://www.site24x7.com/help/api/#list-all-location-profiles
+func (c *Client) LocationProfiles(ctx context.Context) ([]LocationProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_profiles", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving location profiles; message: %s", res.Message)
+	}
+
+	return decode[[]LocationProfile](res.Data)
+}
+
+// CreateLocationProfile establishes a new location profile
+// https://www.site24x7.com/help/api/#create-location-profile
+func (c *Client) CreateLocationProfile(ctx context.Context, lp *LocationProfile) (*LocationProfile, error) {
+	b := lp.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_profiles", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return nil, fmt.Errorf("[api.CreateLocationProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*LocationProfile](res.Data)
+}
+
+// LocationProfile fetches a location profile
+// https://www.site24x7.com/help/api/#retrieve-location-profile
+func (c *Client) LocationProfile(ctx context.Context, id string) (*LocationProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_profiles/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "location profile not found"}
+	}
+
+	return decode[*LocationProfile](res.Data)
+}
+
+// UpdateLocationProfile updates a location profile
+// https://www.site24x7.com/help/api/#update-location-profile
+func (c *Client) UpdateLocationProfile(ctx context.Context, lp *LocationProfile) (*LocationProfile, error) {
+	b := lp.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_profiles/%s", c.APIBaseURL, lp.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateLocationProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*LocationProfile](res.Data)
+}
+
+// DeleteLocationProfile removes a location profile
+// https://www.site24x7.com/help/api/#delete-location-profile
+func (c *Client) DeleteLocationProfile(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/location_profiles/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteLocationProfile] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// LocationProfileList returns Client.LocationProfiles as json for the current profile
+func LocationProfileList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().LocationProfiles(ctx))
+}
+
+// LocationProfileCreate returns Client.CreateLocationProfile as json for the current profile
+func LocationProfileCreate(ctx context.Context, lp *LocationProfile) (json.RawMessage, error) {
+	return encode(defaultClient().CreateLocationProfile(ctx, lp))
+}
+
+// LocationProfileGet returns Client.LocationProfile as json for the current profile
+func LocationProfileGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().LocationProfile(ctx, id))
+}
+
+// LocationProfileUpdate returns Client.UpdateLocationProfile as json for the current profile
+func LocationProfileUpdate(ctx context.Context, lp *LocationProfile) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateLocationProfile(ctx, lp))
+}
+
+// LocationProfileDelete is Client.DeleteLocationProfile for the current profile
+func LocationProfileDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteLocationProfile(ctx, id)
+}
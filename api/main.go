@@ -2,10 +2,48 @@
 
 package api
 
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error describes a request that the Site24x7 API refused or failed to
+// fulfil. Errors of a class that callers commonly handle, e.g. an entity that
+// doesn't exist, are returned as one of the more specific types below, each
+// of which wraps an Error that can be retrieved with errors.As.
+// https://www.site24x7.com/help/api/#error-codes
+type Error struct {
+	Status    int    // the HTTP status code
+	Code      int    // the Site24x7 error code
+	Message   string // the Site24x7 error message
+	RequestID string // identifies the request to Site24x7 support, if given
+}
+
+// Error returns a description of an API error
+func (e *Error) Error() string {
+	details := []string{fmt.Sprintf("HTTP %d", e.Status)}
+	if e.Code != 0 {
+		details = append(details, fmt.Sprintf("code %d", e.Code))
+	}
+	if e.RequestID != "" {
+		details = append(details, fmt.Sprintf("request ID %s", e.RequestID))
+	}
+
+	msg := e.Message
+	if msg == "" {
+		msg = http.StatusText(e.Status)
+	}
+
+	return fmt.Sprintf("%s (%s)", msg, strings.Join(details, ", "))
+}
+
 // NotFoundError defines a custom error that should be returned when an entity
 // being fetched cannot be found.
 type NotFoundError struct {
 	Message string
+	Err     *Error // the API error, if any, that reported it
 }
 
 // Error returns a custom NotFoundError
@@ -13,13 +51,146 @@ func (e *NotFoundError) Error() string {
 	return e.Message
 }
 
+// Unwrap returns the API error, if any, that reported the entity missing
+func (e *NotFoundError) Unwrap() error {
+	return unwrap(e.Err)
+}
+
 // ConflictError defines a custom error that should be returned when an entity
 // being created already exists.
 type ConflictError struct {
 	Message string
+	Err     *Error // the API error, if any, that reported the conflict
 }
 
 // Error returns a custom ConflictError
 func (e *ConflictError) Error() string {
 	return e.Message
 }
+
+// Unwrap returns the API error, if any, that reported the conflict
+func (e *ConflictError) Unwrap() error {
+	return unwrap(e.Err)
+}
+
+// UnauthorizedError is returned when Site24x7 doesn't accept the credentials,
+// even after exchanging the refresh token for a new access token.
+type UnauthorizedError struct {
+	Message string
+	Err     *Error
+}
+
+// Error returns a custom UnauthorizedError
+func (e *UnauthorizedError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the API error, if any, that rejected the credentials
+func (e *UnauthorizedError) Unwrap() error {
+	return unwrap(e.Err)
+}
+
+// ForbiddenError is returned when the credentials are valid but lack the
+// permission, e.g. the OAuth scope or user role, that a request requires.
+type ForbiddenError struct {
+	Message string
+	Err     *Error
+}
+
+// Error returns a custom ForbiddenError
+func (e *ForbiddenError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the API error that refused the request
+func (e *ForbiddenError) Unwrap() error {
+	return unwrap(e.Err)
+}
+
+// RateLimitError is returned when a request is still being throttled after
+// every retry has been spent.
+type RateLimitError struct {
+	Message    string
+	Err        *Error
+	RetryAfter time.Duration // how long Site24x7 asked us to wait, if it said
+}
+
+// Error returns a custom RateLimitError
+func (e *RateLimitError) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the API error that throttled the request
+func (e *RateLimitError) Unwrap() error {
+	return unwrap(e.Err)
+}
+
+// unwrap avoids returning a nil *Error as a non-nil error
+func unwrap(e *Error) error {
+	if e == nil {
+		return nil
+	}
+
+	return e
+}
+
+// The classes of error that callers commonly handle
+const (
+	classNone = iota
+	classUnauthorized
+	classForbidden
+	classNotFound
+	classConflict
+	classRateLimited
+)
+
+// codeClasses classifies the Site24x7 error codes that callers commonly
+// handle, which may accompany any HTTP status, e.g. a 400 for a duplicate.
+// https://www.site24x7.com/help/api/#error-codes
+var codeClasses = map[int]int{
+	1007: classUnauthorized, // invalid OAuth access token
+	1008: classUnauthorized, // OAuth access token expired
+	1021: classForbidden,    // insufficient scope
+	1011: classNotFound,     // resource not found
+	1051: classConflict,     // resource already exists
+	1070: classRateLimited,  // request limit exceeded
+}
+
+// statusClasses classifies the HTTP statuses of errors whose code is unknown
+var statusClasses = map[int]int{
+	http.StatusUnauthorized:    classUnauthorized,
+	http.StatusForbidden:       classForbidden,
+	http.StatusNotFound:        classNotFound,
+	http.StatusConflict:        classConflict,
+	http.StatusTooManyRequests: classRateLimited,
+}
+
+// newError classifies an unsuccessful API response by its Site24x7 error code
+// or, when the code is unknown, its HTTP status
+func newError(status int, h http.Header, res *APIResponse) error {
+	e := &Error{Status: status, RequestID: h.Get("X-Request-Id")}
+	if res != nil {
+		e.Code, e.Message = res.Code, res.Message
+	}
+
+	class, ok := codeClasses[e.Code]
+	if !ok {
+		class = statusClasses[status]
+	}
+
+	switch class {
+	case classUnauthorized:
+		return &UnauthorizedError{Message: e.Error(), Err: e}
+	case classForbidden:
+		return &ForbiddenError{Message: e.Error(), Err: e}
+	case classNotFound:
+		return &NotFoundError{Message: e.Error(), Err: e}
+	case classConflict:
+		return &ConflictError{Message: e.Error(), Err: e}
+	case classRateLimited:
+		d, _ := retryAfter(h)
+		return &RateLimitError{Message: e.Error(), Err: e, RetryAfter: d}
+	}
+
+	return e
+}
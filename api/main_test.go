@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func Test_newError(t *testing.T) {
+	type args struct {
+		status int
+		h      http.Header
+		res    *APIResponse
+	}
+
+	tests := []struct {
+		name     string
+		args     args
+		wantType error
+		wantMsg  string
+	}{
+		{
+			name:     "Classifies an unauthorized request",
+			args:     args{status: 401, h: http.Header{}, res: &APIResponse{Code: 401, Message: "Unauthorized"}},
+			wantType: &UnauthorizedError{},
+			wantMsg:  "Unauthorized (HTTP 401, code 401)",
+		},
+		{
+			name:     "Classifies a forbidden request",
+			args:     args{status: 403, h: http.Header{"X-Request-Id": {"abc"}}, res: &APIResponse{Code: 1021, Message: "Insufficient scope"}},
+			wantType: &ForbiddenError{},
+			wantMsg:  "Insufficient scope (HTTP 403, code 1021, request ID abc)",
+		},
+		{
+			name:     "Classifies a missing entity",
+			args:     args{status: 404, h: http.Header{}},
+			wantType: &NotFoundError{},
+			wantMsg:  "Not Found (HTTP 404)",
+		},
+		{
+			name:     "Classifies a throttled request",
+			args:     args{status: 429, h: http.Header{"Retry-After": {"60"}}, res: &APIResponse{Message: "Too many requests"}},
+			wantType: &RateLimitError{},
+			wantMsg:  "Too many requests (HTTP 429)",
+		},
+		{
+			name:     "Classifies a conflict by its status",
+			args:     args{status: 409, h: http.Header{}},
+			wantType: &ConflictError{},
+			wantMsg:  "Conflict (HTTP 409)",
+		},
+		{
+			name:     "Classifies a duplicate by its code",
+			args:     args{status: 400, h: http.Header{}, res: &APIResponse{Code: 1051, Message: "Email is already registered"}},
+			wantType: &ConflictError{},
+			wantMsg:  "Email is already registered (HTTP 400, code 1051)",
+		},
+		{
+			name:     "Classifies a missing entity by its code",
+			args:     args{status: 400, h: http.Header{}, res: &APIResponse{Code: 1011, Message: "Resource not found"}},
+			wantType: &NotFoundError{},
+			wantMsg:  "Resource not found (HTTP 400, code 1011)",
+		},
+		{
+			name:     "Classifies an expired token by its code",
+			args:     args{status: 400, h: http.Header{}, res: &APIResponse{Code: 1008, Message: "OAuth access token expired"}},
+			wantType: &UnauthorizedError{},
+			wantMsg:  "OAuth access token expired (HTTP 400, code 1008)",
+		},
+		{
+			name:     "Classifies a throttled request by its code",
+			args:     args{status: 400, h: http.Header{}, res: &APIResponse{Code: 1070, Message: "Request limit exceeded"}},
+			wantType: &RateLimitError{},
+			wantMsg:  "Request limit exceeded (HTTP 400, code 1070)",
+		},
+		{
+			name:     "Prefers a known code to the status",
+			args:     args{status: 404, h: http.Header{}, res: &APIResponse{Code: 1051, Message: "Already exists"}},
+			wantType: &ConflictError{},
+			wantMsg:  "Already exists (HTTP 404, code 1051)",
+		},
+		{
+			name:     "Leaves other errors unclassified",
+			args:     args{status: 200, h: http.Header{}, res: &APIResponse{Code: 1042, Message: "Invalid input"}},
+			wantType: &Error{},
+			wantMsg:  "Invalid input (HTTP 200, code 1042)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := newError(tt.args.status, tt.args.h, tt.args.res)
+			if reflect.TypeOf(err) != reflect.TypeOf(tt.wantType) {
+				t.Errorf("newError() = %T, want %T", err, tt.wantType)
+			}
+			if err.Error() != tt.wantMsg {
+				t.Errorf("newError() = %q, want %q", err.Error(), tt.wantMsg)
+			}
+
+			var e *Error
+			if !errors.As(err, &e) || e.Status != tt.args.status {
+				t.Errorf("newError() doesn't wrap an *Error with status %d", tt.args.status)
+			}
+		})
+	}
+
+	// A throttled request says how long to wait
+	err := newError(429, http.Header{"Retry-After": {"60"}}, nil)
+	if rl, ok := err.(*RateLimitError); !ok || rl.RetryAfter != time.Minute {
+		t.Errorf("newError() = %+v, want a RateLimitError with RetryAfter 1m", err)
+	}
+
+	// An error that wasn't reported by the API doesn't wrap a nil *Error
+	var e *Error
+	if errors.As(&NotFoundError{Message: "user not found"}, &e) {
+		t.Errorf("NotFoundError without an API error unwraps to %v, want nothing", e)
+	}
+}
+
+func TestRequest_Fetch_errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"code": 1011, "message": "Resource not found"}`)
+	}))
+	t.Cleanup(srv.Close)
+
+	req := Request{Endpoint: srv.URL, Method: "GET", Headers: http.Header{}}
+	res, err := req.Fetch(context.Background())
+	if res != nil {
+		t.Errorf("Fetch() = %+v, want nil", res)
+	}
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Fatalf("Fetch() error = %T, want *NotFoundError", err)
+	}
+
+	var e *Error
+	errors.As(err, &e)
+	want := &Error{Status: 404, Code: 1011, Message: "Resource not found", RequestID: "req-1"}
+	if !reflect.DeepEqual(e, want) {
+		t.Errorf("Fetch() error wraps %+v, want %+v", e, want)
+	}
+}
+
+func TestRequest_Fetch_empty(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+	}{
+		{name: "Handles a response without data", body: `{"code": 0, "message": "success"}`},
+		{name: "Handles a response without a body"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, tt.body)
+			}))
+			t.Cleanup(srv.Close)
+
+			req := Request{Endpoint: srv.URL, Method: "POST", Headers: http.Header{}}
+			res, err := req.Fetch(context.Background())
+			if err != nil {
+				t.Fatalf("Fetch() error = %v", err)
+			}
+			if res.Message != "success" || res.Data != nil {
+				t.Errorf("Fetch() = %+v, want a success without data", res)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// MaintenanceWindow contains the data returned from any request for scheduled
+// maintenance information. Alerts are suppressed for the targeted monitors
+// while a window is in effect.
+// https://www.site24x7.com/help/api/#schedule-maintenances
+type MaintenanceWindow struct {
+	ID                string   `json:"maintenance_id"`
+	Name              string   `json:"display_name"`
+	Description       string   `json:"description,omitempty"`
+	Type              int      `json:"maintenance_type"` // https://www.site24x7.com/help/api/#maintenance_type
+	StartDate         string   `json:"start_date"`       // yyyy-MM-dd
+	EndDate           string   `json:"end_date"`         // yyyy-MM-dd
+	StartTime         string   `json:"start_time"`       // HH:mm
+	EndTime           string   `json:"end_time"`         // HH:mm
+	TimeZone          string   `json:"time_zone,omitempty"`
+	Days              []int    `json:"week_days,omitempty"`
+	SelectionType     int      `json:"selection_type"` // https://www.site24x7.com/help/api/#resource_type_constants
+	Monitors          []string `json:"monitors,omitempty"`
+	MonitorGroups     []string `json:"monitor_groups,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	PerformMonitoring bool     `json:"perform_monitoring"`
+}
+
+// MaintenanceWindowRequestBody defines the HTTP request body structure
+type MaintenanceWindowRequestBody struct {
+	Name              string   `json:"display_name"`
+	Description       string   `json:"description,omitempty"`
+	Type              int      `json:"maintenance_type"`
+	StartDate         string   `json:"start_date"`
+	EndDate           string   `json:"end_date"`
+	StartTime         string   `json:"start_time"`
+	EndTime           string   `json:"end_time"`
+	TimeZone          string   `json:"time_zone,omitempty"`
+	Days              []int    `json:"week_days,omitempty"`
+	SelectionType     int      `json:"selection_type"`
+	Monitors          []string `json:"monitors,omitempty"`
+	MonitorGroups     []string `json:"monitor_groups,omitempty"`
+	Tags              []string `json:"tags,omitempty"`
+	PerformMonitoring bool     `json:"perform_monitoring"`
+}
+
+// toRequestBody performs a struct conversion
+func (mw *MaintenanceWindow) toRequestBody() []byte {
+	var b MaintenanceWindowRequestBody
+	tmp, _ := json.Marshal(mw)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// MaintenanceWindows returns all maintenance windows
+// https://www.site24x7.com/help/api/#list-of-all-maintenance
+func (c *Client) MaintenanceWindows(ctx context.Context) ([]MaintenanceWindow, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/maintenance", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving maintenance windows; message: %s", res.Message)
+	}
+
+	return decode[[]MaintenanceWindow](res.Data)
+}
+
+// CreateMaintenanceWindow establishes a new maintenance window
+// https://www.site24x7.com/help/api/#create-one-time-maintenance
+func (c *Client) CreateMaintenanceWindow(ctx context.Context, mw *MaintenanceWindow) (*MaintenanceWindow, error) {
+	b := mw.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/maintenance", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return nil, fmt.Errorf("[api.CreateMaintenanceWindow] API Response error; %s", res.Message)
+	}
+
+	return decode[*MaintenanceWindow](res.Data)
+}
+
+// MaintenanceWindow fetches a maintenance window
+// https://www.site24x7.com/help/api/#retrieve-maintenance
+func (c *Client) MaintenanceWindow(ctx context.Context, id string) (*MaintenanceWindow, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/maintenance/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "maintenance window not found"}
+	}
+
+	return decode[*MaintenanceWindow](res.Data)
+}
+
+// UpdateMaintenanceWindow updates a maintenance window
+// https://www.site24x7.com/help/api/#update-maintenance
+func (c *Client) UpdateMaintenanceWindow(ctx context.Context, mw *MaintenanceWindow) (*MaintenanceWindow, error) {
+	b := mw.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/maintenance/%s", c.APIBaseURL, mw.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateMaintenanceWindow] API Response error; %s", res.Message)
+	}
+
+	return decode[*MaintenanceWindow](res.Data)
+}
+
+// DeleteMaintenanceWindow removes a maintenance window
+// https://www.site24x7.com/help/api/#delete-maintenance
+func (c *Client) DeleteMaintenanceWindow(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/maintenance/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteMaintenanceWindow] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// MaintenanceWindowList returns Client.MaintenanceWindows as json for the current profile
+func MaintenanceWindowList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().MaintenanceWindows(ctx))
+}
+
+// MaintenanceWindowCreate returns Client.CreateMaintenanceWindow as json for the current profile
+func MaintenanceWindowCreate(ctx context.Context, mw *MaintenanceWindow) (json.RawMessage, error) {
+	return encode(defaultClient().CreateMaintenanceWindow(ctx, mw))
+}
+
+// MaintenanceWindowGet returns Client.MaintenanceWindow as json for the current profile
+func MaintenanceWindowGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().MaintenanceWindow(ctx, id))
+}
+
+// MaintenanceWindowUpdate returns Client.UpdateMaintenanceWindow as json for the current profile
+func MaintenanceWindowUpdate(ctx context.Context, mw *MaintenanceWindow) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateMaintenanceWindow(ctx, mw))
+}
+
+// MaintenanceWindowDelete is Client.DeleteMaintenanceWindow for the current profile
+func MaintenanceWindowDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteMaintenanceWindow(ctx, id)
+}
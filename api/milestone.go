@@ -0,0 +1,133 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// Milestone contains the data returned from any request for milestone marker
+// information. A milestone, e.g. a deployment, is marked on the graphs of the
+// monitors that it targets.
+// https://www.site24x7.com/help/api/#milestone-marker
+type Milestone struct {
+	ID            string   `json:"milestone_id,omitempty"`
+	Name          string   `json:"milestone_name"`
+	MarkedTime    string   `json:"marked_time"`    // yyyy-MM-ddTHH:mm:ssZ
+	SelectionType int      `json:"selection_type"` // https://www.site24x7.com/help/api/#resource_type_constants
+	Monitors      []string `json:"monitors,omitempty"`
+	MonitorGroups []string `json:"monitor_groups,omitempty"`
+}
+
+// MilestoneRequestBody defines the HTTP request body structure
+type MilestoneRequestBody struct {
+	Name          string   `json:"milestone_name"`
+	MarkedTime    string   `json:"marked_time"`
+	SelectionType int      `json:"selection_type"`
+	Monitors      []string `json:"monitors,omitempty"`
+	MonitorGroups []string `json:"monitor_groups,omitempty"`
+}
+
+// toRequestBody performs a struct conversion
+func (m *Milestone) toRequestBody() []byte {
+	var b MilestoneRequestBody
+	tmp, _ := json.Marshal(m)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// Milestones returns all milestone markers
+// https://www.site24x7.com/help/api/#list-milestone-markers
+func (c *Client) Milestones(ctx context.Context) ([]Milestone, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/milestone", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving milestones; message: %s", res.Message)
+	}
+
+	return decode[[]Milestone](res.Data)
+}
+
+// CreateMilestone marks a milestone. Site24x7 responds with an
+// EmptyAPIResponse, so there's no data to return.
+// https://www.site24x7.com/help/api/#add-a-milestone-marker
+func (c *Client) CreateMilestone(ctx context.Context, m *Milestone) error {
+	b := m.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/milestone", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return fmt.Errorf("[api.CreateMilestone] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// DeleteMilestone removes a milestone marker
+// https://www.site24x7.com/help/api/#delete-milestone-marker
+func (c *Client) DeleteMilestone(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/milestone/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteMilestone] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// MilestoneList returns Client.Milestones as json for the current profile
+func MilestoneList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().Milestones(ctx))
+}
+
+// MilestoneCreate is Client.CreateMilestone for the current profile
+func MilestoneCreate(ctx context.Context, m *Milestone) error {
+	return defaultClient().CreateMilestone(ctx, m)
+}
+
+// MilestoneDelete is Client.DeleteMilestone for the current profile
+func MilestoneDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteMilestone(ctx, id)
+}
@@ -0,0 +1,445 @@
+// The mock/ package provides a fake Site24x7 API, with in-memory state, for
+// testing and demonstrating the CLI without a real account. It serves the Zoho
+// OAuth token endpoint and the users, user_groups and monitor_groups endpoints.
+//
+// It doesn't depend on the api/ package, so that package's tests can use it.
+
+package mock
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// The resources, i.e. API paths, that the server implements
+const (
+	Users         = "users"
+	UserGroups    = "user_groups"
+	MonitorGroups = "monitor_groups"
+)
+
+// APIPath is the path of the API relative to the server's URL, i.e. a client
+// uses <server URL>/api as the API base URL and <server URL> as the auth base
+// URL
+const APIPath = "/api"
+
+// The codes that accompany errors, modelled on Site24x7's
+// https://www.site24x7.com/help/api/#error-codes
+const (
+	codeInvalidInput = 1042
+	codeNotFound     = 1011
+	codeUnauthorized = 1007
+	codeDuplicate    = 1051
+)
+
+// firstID is the identifier given to the first object created; Site24x7
+// identifiers are large numbers, serialized as strings
+const firstID = 306947000000025001
+
+// accessTokenLifetime is the lifetime, in seconds, of the access tokens issued
+const accessTokenLifetime = 3600
+
+// collection holds the objects of a single resource
+type collection struct {
+	idField  string   // the property that identifies an object
+	required []string // the properties that an object must have
+	objects  map[string]map[string]interface{}
+	order    []string // identifiers in the order that objects were created
+}
+
+// newCollection returns an empty collection of objects identified by a
+// property
+func newCollection(idField string, required ...string) *collection {
+	return &collection{idField: idField, required: required, objects: map[string]map[string]interface{}{}}
+}
+
+// Server is a fake Site24x7 API. Objects are stored as they're sent, along
+// with an identifier, so any property the CLI sends is returned to it. The
+// zero value isn't usable; create a Server with New.
+type Server struct {
+	// RefreshToken, if set, is the only refresh token that's exchanged for
+	// access tokens; otherwise any is
+	RefreshToken string
+
+	mu          sync.Mutex
+	collections map[string]*collection
+	tokens      map[string]bool // access tokens that haven't been revoked
+	lastID      int64
+	issued      int // access tokens issued, to make each unique
+	requests    int // requests received, to give each an ID
+}
+
+// New returns a server with no objects
+func New() *Server {
+	return &Server{
+		collections: map[string]*collection{
+			Users:         newCollection("user_id", "display_name", "email_address"),
+			UserGroups:    newCollection("user_group_id", "display_name"),
+			MonitorGroups: newCollection("group_id", "display_name"),
+		},
+		tokens: map[string]bool{},
+		lastID: firstID - 1,
+	}
+}
+
+// Start serves the API from a new httptest.Server, which the caller must
+// close, e.g.
+//
+//	srv := mock.New().Start()
+//	defer srv.Close()
+//	client := api.NewClient(srv.URL+mock.APIPath, api.NewRefreshTokenSource(srv.URL, creds, nil), nil)
+func (s *Server) Start() *httptest.Server {
+	return httptest.NewServer(s)
+}
+
+// Add stores an object, e.g. a test fixture, as if it had been created
+// through the API and returns its identifier
+func (s *Server) Add(resource string, object map[string]interface{}) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.add(s.collections[resource], object)
+}
+
+// Get returns a copy of a stored object, or nil if it doesn't exist
+func (s *Server) Get(resource string, id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	o, ok := s.collections[resource].objects[id]
+	if !ok {
+		return nil
+	}
+
+	return clone(o)
+}
+
+// RevokeTokens invalidates every access token issued so far, as though each
+// had expired early, so that requests made with them are rejected
+func (s *Server) RevokeTokens() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens = map[string]bool{}
+}
+
+// ServeHTTP routes a request to the token endpoint or to a resource
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests++
+	w.Header().Set("X-Request-Id", fmt.Sprintf("mock-%d", s.requests))
+
+	if r.URL.Path == "/oauth/v2/token" {
+		s.token(w, r)
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, APIPath+"/") {
+		writeError(w, http.StatusNotFound, codeNotFound, "Resource not found")
+		return
+	}
+	if !s.authorized(r) {
+		writeError(w, http.StatusUnauthorized, codeUnauthorized, "Invalid OAuth access token")
+		return
+	}
+
+	resource, id, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, APIPath+"/"), "/")
+	c, ok := s.collections[resource]
+	if !ok || strings.Contains(id, "/") {
+		writeError(w, http.StatusNotFound, codeNotFound, "Resource not found")
+		return
+	}
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		s.list(w, c)
+	case id == "" && r.Method == http.MethodPost:
+		s.create(w, r, resource, c)
+	case id != "" && r.Method == http.MethodGet:
+		s.read(w, c, id)
+	case id != "" && r.Method == http.MethodPut:
+		s.update(w, r, resource, c, id)
+	case id != "" && r.Method == http.MethodDelete:
+		s.delete(w, resource, c, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, codeInvalidInput, fmt.Sprintf("%s isn't supported", r.Method))
+	}
+}
+
+// token exchanges a grant token for a refresh token and an access token, or a
+// refresh token for an access token. Like Zoho's, it reports a failure in the
+// body of a successful response.
+func (s *Server) token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.ParseForm() != nil || r.PostForm.Get("client_id") == "" {
+		writeJSON(w, http.StatusOK, map[string]string{"error": "invalid_client"})
+		return
+	}
+
+	t := map[string]interface{}{
+		"api_domain": "https://www.zohoapis.com",
+		"token_type": "Bearer",
+		"expires_in": accessTokenLifetime,
+	}
+
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		if r.PostForm.Get("code") == "" {
+			writeJSON(w, http.StatusOK, map[string]string{"error": "invalid_code"})
+			return
+		}
+		refresh := s.RefreshToken
+		if refresh == "" {
+			refresh = "mock-refresh-token"
+		}
+		t["refresh_token"] = refresh
+	case "refresh_token":
+		refresh := r.PostForm.Get("refresh_token")
+		if refresh == "" || (s.RefreshToken != "" && refresh != s.RefreshToken) {
+			writeJSON(w, http.StatusOK, map[string]string{"error": "invalid_code"})
+			return
+		}
+	default:
+		writeJSON(w, http.StatusOK, map[string]string{"error": "unsupported_grant_type"})
+		return
+	}
+
+	s.issued++
+	access := fmt.Sprintf("mock-access-token-%d", s.issued)
+	s.tokens[access] = true
+	t["access_token"] = access
+
+	writeJSON(w, http.StatusOK, t)
+}
+
+// authorized reports whether a request carries a valid access token
+func (s *Server) authorized(r *http.Request) bool {
+	const scheme = "Zoho-oauthtoken "
+	h := r.Header.Get("Authorization")
+
+	return strings.HasPrefix(h, scheme) && s.tokens[strings.TrimPrefix(h, scheme)]
+}
+
+// list responds with every object of a resource, in the order created
+func (s *Server) list(w http.ResponseWriter, c *collection) {
+	objects := make([]map[string]interface{}, 0, len(c.order))
+	for _, id := range c.order {
+		objects = append(objects, c.objects[id])
+	}
+
+	writeData(w, http.StatusOK, objects)
+}
+
+// create stores a new object
+func (s *Server) create(w http.ResponseWriter, r *http.Request, resource string, c *collection) {
+	o, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	for _, p := range c.required {
+		if v, _ := o[p].(string); v == "" {
+			writeError(w, http.StatusBadRequest, codeInvalidInput, fmt.Sprintf("%s is required", p))
+			return
+		}
+	}
+	if resource == Users && s.registered(c, o["email_address"], "") {
+		writeError(w, http.StatusBadRequest, codeDuplicate, "Email is already registered")
+		return
+	}
+
+	id := s.add(c, o)
+
+	writeData(w, http.StatusCreated, c.objects[id])
+}
+
+// read responds with a single object
+func (s *Server) read(w http.ResponseWriter, c *collection, id string) {
+	o, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeNotFound, "Resource not found")
+		return
+	}
+
+	writeData(w, http.StatusOK, o)
+}
+
+// update overlays the properties sent onto an object; its identifier can't
+// be changed
+func (s *Server) update(w http.ResponseWriter, r *http.Request, resource string, c *collection, id string) {
+	o, ok := c.objects[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeNotFound, "Resource not found")
+		return
+	}
+
+	changes, ok := decode(w, r)
+	if !ok {
+		return
+	}
+	if resource == Users && s.registered(c, changes["email_address"], id) {
+		writeError(w, http.StatusBadRequest, codeDuplicate, "Email is already registered")
+		return
+	}
+
+	for k, v := range changes {
+		o[k] = v
+	}
+	o[c.idField] = id
+
+	writeData(w, http.StatusOK, o)
+}
+
+// delete removes an object, and a deleted user from any user group
+func (s *Server) delete(w http.ResponseWriter, resource string, c *collection, id string) {
+	if _, ok := c.objects[id]; !ok {
+		writeError(w, http.StatusNotFound, codeNotFound, "Resource not found")
+		return
+	}
+
+	delete(c.objects, id)
+	for i, v := range c.order {
+		if v == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			break
+		}
+	}
+
+	if resource == Users {
+		for _, ug := range s.collections[UserGroups].objects {
+			ug["users"] = without(ug["users"], id)
+		}
+	}
+
+	writeData(w, http.StatusOK, map[string]interface{}{})
+}
+
+// add stores an object under a new identifier; the caller holds the lock
+func (s *Server) add(c *collection, object map[string]interface{}) string {
+	s.lastID++
+	id := strconv.FormatInt(s.lastID, 10)
+
+	o := clone(object)
+	o[c.idField] = id
+	c.objects[id] = o
+	c.order = append(c.order, id)
+
+	return id
+}
+
+// registered reports whether a user other than the one identified already
+// has an email address
+func (s *Server) registered(c *collection, email interface{}, id string) bool {
+	e, _ := email.(string)
+	if e == "" {
+		return false
+	}
+
+	for uid, u := range c.objects {
+		if existing, _ := u["email_address"].(string); uid != id && strings.EqualFold(existing, e) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decode parses the object in a request body, responding with an error if
+// it can't
+func decode(w http.ResponseWriter, r *http.Request) (map[string]interface{}, bool) {
+	var o map[string]interface{}
+	if err := json.NewDecoder(r.Body).Decode(&o); err != nil || o == nil {
+		writeError(w, http.StatusBadRequest, codeInvalidInput, "Invalid JSON in request body")
+		return nil, false
+	}
+
+	return o, true
+}
+
+// clone copies an object, e.g. so a caller can't change a stored object
+func clone(o map[string]interface{}) map[string]interface{} {
+	b, _ := json.Marshal(o)
+	var c map[string]interface{}
+	json.Unmarshal(b, &c)
+	if c == nil {
+		c = map[string]interface{}{}
+	}
+
+	return c
+}
+
+// without returns a list of identifiers without the one given
+func without(list interface{}, id string) []interface{} {
+	ids, _ := list.([]interface{})
+
+	kept := []interface{}{}
+	for _, v := range ids {
+		if v != id {
+			kept = append(kept, v)
+		}
+	}
+
+	return kept
+}
+
+// writeData responds with data in the envelope of a successful request
+func writeData(w http.ResponseWriter, status int, data interface{}) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":    0,
+		"message": "success",
+		"data":    data,
+	})
+}
+
+// writeError responds with the envelope of a failed request
+func writeError(w http.ResponseWriter, status int, code int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"code":    code,
+		"message": message,
+	})
+}
+
+// writeJSON responds with a json body
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// Seed adds the objects of a small demo account: an account contact and an
+// on-call engineer, a user group of both and an empty monitor group
+func (s *Server) Seed() {
+	admin := s.Add(Users, map[string]interface{}{
+		"display_name":       "Ada Admin",
+		"email_address":      "ada@example.com",
+		"user_role":          1,
+		"job_title":          1,
+		"notify_medium":      []int{1},
+		"user_groups":        []string{},
+		"is_account_contact": true,
+	})
+	engineer := s.Add(Users, map[string]interface{}{
+		"display_name":  "Otto Oncall",
+		"email_address": "otto@example.com",
+		"user_role":     2,
+		"job_title":     2,
+		"notify_medium": []int{1, 2},
+		"user_groups":   []string{},
+	})
+	s.Add(UserGroups, map[string]interface{}{
+		"display_name": "Operations",
+		"product_id":   0,
+		"users":        []string{admin, engineer},
+	})
+	s.Add(MonitorGroups, map[string]interface{}{
+		"display_name":           "Production",
+		"description":            "Customer-facing services",
+		"monitors":               []string{},
+		"health_threshold_count": 1,
+	})
+}
@@ -0,0 +1,164 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"testing"
+)
+
+// newClient starts a server and returns a client of it that authenticates
+// with a refresh token
+func newClient(t *testing.T, s *Server) *api.Client {
+	srv := s.Start()
+	t.Cleanup(srv.Close)
+
+	tokens := api.NewRefreshTokenSource(srv.URL, api.Credentials{ClientID: "client", ClientSecret: "secret", RefreshToken: "refresh"}, nil)
+	c := api.NewClient(srv.URL+APIPath, tokens, nil)
+	c.Retries = 0
+
+	return c
+}
+
+func TestServer_users(t *testing.T) {
+	ctx := context.Background()
+	c := newClient(t, New())
+
+	created, err := c.CreateUser(ctx, &api.User{Name: "Alice", EmailAddress: "alice@example.com", Role: 2, NotificationMethods: []int{1}})
+	if err != nil {
+		t.Fatalf("Client.CreateUser() error = %v", err)
+	}
+	if created.ID == "" || created.Name != "Alice" {
+		t.Errorf("Client.CreateUser() = %+v, want Alice with an ID", created)
+	}
+
+	_, err = c.CreateUser(ctx, &api.User{Name: "Alice again", EmailAddress: "ALICE@example.com"})
+	var conflict *api.ConflictError
+	if !errors.As(err, &conflict) {
+		t.Errorf("Client.CreateUser() error = %v, want a ConflictError", err)
+	}
+
+	created.JobTitle = 3
+	updated, err := c.UpdateUser(ctx, created)
+	if err != nil {
+		t.Fatalf("Client.UpdateUser() error = %v", err)
+	}
+	if updated.JobTitle != 3 || updated.ID != created.ID {
+		t.Errorf("Client.UpdateUser() = %+v, want job title 3", updated)
+	}
+
+	got, err := c.User(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("Client.User() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, updated) {
+		t.Errorf("Client.User() = %+v, want %+v", got, updated)
+	}
+
+	users, err := c.Users(ctx)
+	if err != nil {
+		t.Fatalf("Client.Users() error = %v", err)
+	}
+	if len(users) != 1 || users[0].EmailAddress != "alice@example.com" {
+		t.Errorf("Client.Users() = %+v, want alice@example.com", users)
+	}
+
+	if err := c.DeleteUser(ctx, created.ID); err != nil {
+		t.Fatalf("Client.DeleteUser() error = %v", err)
+	}
+
+	_, err = c.User(ctx, created.ID)
+	var notFound *api.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("Client.User() error = %v, want a NotFoundError", err)
+	}
+	var e *api.Error
+	if !errors.As(err, &e) || e.Code != codeNotFound || e.RequestID == "" {
+		t.Errorf("Client.User() error wraps %+v, want code %d and a request ID", e, codeNotFound)
+	}
+}
+
+func TestServer_groups(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+	s.Seed()
+	c := newClient(t, s)
+
+	users, err := c.Users(ctx)
+	if err != nil {
+		t.Fatalf("Client.Users() error = %v", err)
+	}
+
+	ug, err := c.CreateUserGroup(ctx, &api.UserGroup{Name: "Developers", Users: []string{users[0].ID, users[1].ID}})
+	if err != nil {
+		t.Fatalf("Client.CreateUserGroup() error = %v", err)
+	}
+
+	// Deleting a user removes them from the group
+	if err := c.DeleteUser(ctx, users[1].ID); err != nil {
+		t.Fatalf("Client.DeleteUser() error = %v", err)
+	}
+	if ug, err = c.UserGroup(ctx, ug.ID); err != nil {
+		t.Fatalf("Client.UserGroup() error = %v", err)
+	}
+	if want := []string{users[0].ID}; !reflect.DeepEqual(ug.Users, want) {
+		t.Errorf("Client.UserGroup() users = %v, want %v", ug.Users, want)
+	}
+
+	ugs, err := c.UserGroups(ctx)
+	if err != nil {
+		t.Fatalf("Client.UserGroups() error = %v", err)
+	}
+	if len(ugs) != 2 || ugs[1].Name != "Developers" {
+		t.Errorf("Client.UserGroups() = %+v, want Operations and Developers", ugs)
+	}
+
+	mg, err := c.CreateMonitorGroup(ctx, &api.MonitorGroup{Name: "Staging", HealthThresholdCount: 2})
+	if err != nil {
+		t.Fatalf("Client.CreateMonitorGroup() error = %v", err)
+	}
+	mgs, err := c.MonitorGroups(ctx, true)
+	if err != nil {
+		t.Fatalf("Client.MonitorGroups() error = %v", err)
+	}
+	if len(mgs) != 2 || mgs[1].ID != mg.ID || mgs[1].HealthThresholdCount != 2 {
+		t.Errorf("Client.MonitorGroups() = %+v, want Production and Staging", mgs)
+	}
+
+	if _, err := c.CreateMonitorGroup(ctx, &api.MonitorGroup{}); err == nil {
+		t.Errorf("Client.CreateMonitorGroup() error = nil, want one for a missing name")
+	}
+}
+
+func TestServer_tokens(t *testing.T) {
+	ctx := context.Background()
+	s := New()
+	s.Seed()
+	c := newClient(t, s)
+
+	if _, err := c.Users(ctx); err != nil {
+		t.Fatalf("Client.Users() error = %v", err)
+	}
+
+	// A revoked token is refreshed
+	s.RevokeTokens()
+	if _, err := c.Users(ctx); err != nil {
+		t.Errorf("Client.Users() error = %v after revoking tokens, want a refresh", err)
+	}
+
+	// A static token can't be
+	srv := s.Start()
+	t.Cleanup(srv.Close)
+	var unauthorized *api.UnauthorizedError
+	if _, err := api.NewClient(srv.URL+APIPath, api.StaticToken("stolen"), nil).Users(ctx); !errors.As(err, &unauthorized) {
+		t.Errorf("Client.Users() error = %v, want an UnauthorizedError", err)
+	}
+
+	// Only the server's refresh token is exchanged, when it has one
+	s.RefreshToken = "the refresh token"
+	s.RevokeTokens()
+	if _, err := c.Users(ctx); !errors.As(err, &unauthorized) {
+		t.Errorf("Client.Users() error = %v, want an UnauthorizedError", err)
+	}
+}
@@ -0,0 +1,295 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// Monitor contains the data returned from any request for monitor information.
+// Site24x7 supports many types of monitor, each with its own set of properties;
+// this covers the most common: website (URL), REST API (RESTAPI), ping (PING),
+// port (PORT), DNS (DNS), SSL certificate (SSL_CERT) and heartbeat (HEARTBEAT)
+// monitors.
+// https://www.site24x7.com/help/api/#monitors
+type Monitor struct {
+	ID                    string   `json:"monitor_id"`
+	Name                  string   `json:"display_name"`
+	Type                  string   `json:"type"`
+	State                 int      `json:"state"` // https://www.site24x7.com/help/api/#monitor_state_constants
+	CheckFrequency        string   `json:"check_frequency,omitempty"`
+	Timeout               *int     `json:"timeout,omitempty"`
+	LocationProfileID     string   `json:"location_profile_id,omitempty"`
+	NotificationProfileID string   `json:"notification_profile_id,omitempty"`
+	ThresholdProfileID    string   `json:"threshold_profile_id,omitempty"`
+	MonitorGroups         []string `json:"monitor_groups,omitempty"`
+	UserGroups            []string `json:"user_group_ids,omitempty"`
+	Tags                  []string `json:"tag_ids,omitempty"`
+
+	// Website & REST API monitors
+	Website             string `json:"website,omitempty"`
+	HTTPMethod          string `json:"http_method,omitempty"`
+	RequestContentType  string `json:"request_content_type,omitempty"`
+	RequestBody         string `json:"request_param,omitempty"`
+	ResponseContentType string `json:"response_content_type,omitempty"`
+	MatchCase           *bool  `json:"match_case,omitempty"`
+	UserAgent           string `json:"user_agent,omitempty"`
+
+	// Ping & port monitors
+	HostName string `json:"host_name,omitempty"`
+	Port     *int   `json:"port,omitempty"`
+	UseSSL   *bool  `json:"use_ssl,omitempty"`
+	UseIPv6  *bool  `json:"use_ipv6,omitempty"`
+
+	// DNS & SSL certificate monitors
+	DomainName string `json:"domain_name,omitempty"`
+	DNSHost    string `json:"dns_host,omitempty"`
+	DNSPort    string `json:"dns_port,omitempty"`
+	LookupType int    `json:"lookup_type,omitempty"` // https://www.site24x7.com/help/api/#dns_lookup_type
+	ExpireDays int    `json:"expire_days,omitempty"`
+
+	// Heartbeat monitors
+	NameInPingURL string `json:"name_in_ping_url,omitempty"`
+	PingURL       string `json:"ping_url,omitempty"` // read-only
+}
+
+// MonitorRequestBody defines the HTTP request body structure. Flags and
+// numbers that Site24x7 defaults are pointers, so that they're only sent when
+// they're set, and then even when they're false or 0.
+type MonitorRequestBody struct {
+	Name                  string   `json:"display_name"`
+	Type                  string   `json:"type"`
+	CheckFrequency        string   `json:"check_frequency,omitempty"`
+	Timeout               *int     `json:"timeout,omitempty"`
+	LocationProfileID     string   `json:"location_profile_id,omitempty"`
+	NotificationProfileID string   `json:"notification_profile_id,omitempty"`
+	ThresholdProfileID    string   `json:"threshold_profile_id,omitempty"`
+	MonitorGroups         []string `json:"monitor_groups,omitempty"`
+	UserGroups            []string `json:"user_group_ids,omitempty"`
+	Tags                  []string `json:"tag_ids,omitempty"`
+	Website               string   `json:"website,omitempty"`
+	HTTPMethod            string   `json:"http_method,omitempty"`
+	RequestContentType    string   `json:"request_content_type,omitempty"`
+	RequestBody           string   `json:"request_param,omitempty"`
+	ResponseContentType   string   `json:"response_content_type,omitempty"`
+	MatchCase             *bool    `json:"match_case,omitempty"`
+	UserAgent             string   `json:"user_agent,omitempty"`
+	HostName              string   `json:"host_name,omitempty"`
+	Port                  *int     `json:"port,omitempty"`
+	UseSSL                *bool    `json:"use_ssl,omitempty"`
+	UseIPv6               *bool    `json:"use_ipv6,omitempty"`
+	DomainName            string   `json:"domain_name,omitempty"`
+	DNSHost               string   `json:"dns_host,omitempty"`
+	DNSPort               string   `json:"dns_port,omitempty"`
+	LookupType            int      `json:"lookup_type,omitempty"`
+	ExpireDays            int      `json:"expire_days,omitempty"`
+	NameInPingURL         string   `json:"name_in_ping_url,omitempty"`
+}
+
+// toRequestBody performs a struct conversion
+func (m *Monitor) toRequestBody() []byte {
+	var b MonitorRequestBody
+	tmp, _ := json.Marshal(m)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// Monitors returns all monitors
+// https://www.site24x7.com/help/api/#list-of-all-monitors
+func (c *Client) Monitors(ctx context.Context) ([]Monitor, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving monitors; message: %s", res.Message)
+	}
+
+	return decode[[]Monitor](res.Data)
+}
+
+// CreateMonitor establishes a new monitor
+// https://www.site24x7.com/help/api/#create-monitor
+func (c *Client) CreateMonitor(ctx context.Context, m *Monitor) (*Monitor, error) {
+	b := m.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+
+		return nil, fmt.Errorf("[api.CreateMonitor] API Response error; %s", res.Message)
+	}
+
+	return decode[*Monitor](res.Data)
+}
+
+// Monitor fetches a monitor
+// https://www.site24x7.com/help/api/#retrieve-monitor
+func (c *Client) Monitor(ctx context.Context, id string) (*Monitor, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "monitor not found"}
+	}
+
+	return decode[*Monitor](res.Data)
+}
+
+// UpdateMonitor updates a monitor
+// https://www.site24x7.com/help/api/#update-monitor
+func (c *Client) UpdateMonitor(ctx context.Context, m *Monitor) (*Monitor, error) {
+	b := m.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors/%s", c.APIBaseURL, m.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateMonitor] API Response error; %s", res.Message)
+	}
+
+	return decode[*Monitor](res.Data)
+}
+
+// DeleteMonitor removes a monitor
+// https://www.site24x7.com/help/api/#delete-monitor
+func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteMonitor] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// ActivateMonitor resumes monitoring for a suspended monitor
+// https://www.site24x7.com/help/api/#activate-monitor
+func (c *Client) ActivateMonitor(ctx context.Context, id string) error {
+	return c.setMonitorState(ctx, id, "activate")
+}
+
+// SuspendMonitor suspends monitoring for a monitor
+// https://www.site24x7.com/help/api/#suspend-monitor
+func (c *Client) SuspendMonitor(ctx context.Context, id string) error {
+	return c.setMonitorState(ctx, id, "suspend")
+}
+
+// setMonitorState activates or suspends a monitor
+func (c *Client) setMonitorState(ctx context.Context, id string, action string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/monitors/%s/%s", c.APIBaseURL, action, id),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.setMonitorState] Unable to %s monitor; %s", action, res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// MonitorList returns Client.Monitors as json for the current profile
+func MonitorList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().Monitors(ctx))
+}
+
+// MonitorCreate returns Client.CreateMonitor as json for the current profile
+func MonitorCreate(ctx context.Context, m *Monitor) (json.RawMessage, error) {
+	return encode(defaultClient().CreateMonitor(ctx, m))
+}
+
+// MonitorGet returns Client.Monitor as json for the current profile
+func MonitorGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().Monitor(ctx, id))
+}
+
+// MonitorUpdate returns Client.UpdateMonitor as json for the current profile
+func MonitorUpdate(ctx context.Context, m *Monitor) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateMonitor(ctx, m))
+}
+
+// MonitorDelete is Client.DeleteMonitor for the current profile
+func MonitorDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteMonitor(ctx, id)
+}
+
+// MonitorActivate is Client.ActivateMonitor for the current profile
+func MonitorActivate(ctx context.Context, id string) error {
+	return defaultClient().ActivateMonitor(ctx, id)
+}
+
+// MonitorSuspend is Client.SuspendMonitor for the current profile
+func MonitorSuspend(ctx context.Context, id string) error {
+	return defaultClient().SuspendMonitor(ctx, id)
+}
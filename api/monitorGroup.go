@@ -1,11 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
-	"os"
 	"site24x7/logger"
 	"strconv"
 )
@@ -34,6 +34,7 @@ type MonitorGroupRequestBody struct {
 	HealthThresholdCount int      `json:"health_threshold_count"`
 	DependentMonitors    []string `json:"dependency_resource_ids"`
 	SuppressAlert        bool     `json:"suppress_alert"`
+	Tags                 []string `json:"tags,omitempty"`
 }
 
 // toRequestBody performs a struct conversion
@@ -46,11 +47,11 @@ func (mg *MonitorGroup) toRequestBody() []byte {
 	return body
 }
 
-// MonitorGroupList returns all monitor groups
+// MonitorGroups returns all monitor groups
 // https://www.site24x7.com/help/api/#list-of-all-monitor-groups
-func MonitorGroupList(withSubgroups bool) (json.RawMessage, error) {
+func (c *Client) MonitorGroups(ctx context.Context, withSubgroups bool) ([]MonitorGroup, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/monitor_groups", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/monitor_groups", c.APIBaseURL),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.1"},
@@ -59,9 +60,9 @@ func MonitorGroupList(withSubgroups bool) (json.RawMessage, error) {
 		QueryString: url.Values{
 			"subgroup_required": {strconv.FormatBool(withSubgroups)},
 		},
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -70,105 +71,133 @@ func MonitorGroupList(withSubgroups bool) (json.RawMessage, error) {
 		return nil, fmt.Errorf("Error retrieving monitor groups; message: %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[[]MonitorGroup](res.Data)
 }
 
-// MonitorGroupCreate establishes a new monitor group if a group with the same name does
+// CreateMonitorGroup establishes a new monitor group if a group with the same name does
 // not already exist
 // https://www.site24x7.com/help/api/#create-monitor-group
-func MonitorGroupCreate(mg *MonitorGroup) (json.RawMessage, error) {
+func (c *Client) CreateMonitorGroup(ctx context.Context, mg *MonitorGroup) (*MonitorGroup, error) {
 	b := mg.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/monitor_groups", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/monitor_groups", c.APIBaseURL),
 		Method:   "POST",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.1"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if string(res.Data) == "{}" || res.Message != "success" {
 		logger.Debug(fmt.Sprintf("Response\n%+v", res))
 
-		return nil, fmt.Errorf("[api.MonitorGroupCreate] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.CreateMonitorGroup] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*MonitorGroup](res.Data)
 }
 
-// MonitorGroupGet fetches a monitor group
+// MonitorGroup fetches a monitor group
 // https://www.site24x7.com/help/api/#retrieve-monitor-group
-func MonitorGroupGet(id string) (json.RawMessage, error) {
+func (c *Client) MonitorGroup(ctx context.Context, id string) (*MonitorGroup, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", c.APIBaseURL, id),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if string(res.Data) == "{}" {
 		// Handle a "known" error just a little bit more cleanly
-		return nil, &NotFoundError{"monitor group not found"}
+		return nil, &NotFoundError{Message: "monitor group not found"}
 	}
 
-	return res.Data, nil
+	return decode[*MonitorGroup](res.Data)
 }
 
-// MonitorGroupUpdate updates a monitor group
+// UpdateMonitorGroup updates a monitor group
 // https://www.site24x7.com/help/api/#update-monitor-group
-func MonitorGroupUpdate(mg *MonitorGroup) (json.RawMessage, error) {
+func (c *Client) UpdateMonitorGroup(ctx context.Context, mg *MonitorGroup) (*MonitorGroup, error) {
 	b := mg.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", os.Getenv("API_BASE_URL"), mg.ID),
+		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", c.APIBaseURL, mg.ID),
 		Method:   "PUT",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.1"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if string(res.Data) == "{}" || res.Message != "success" {
-		return nil, fmt.Errorf("[api.MonitorGroupUpdate] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.UpdateMonitorGroup] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*MonitorGroup](res.Data)
 }
 
-// MonitorGroupDelete removes a monitor group
-func MonitorGroupDelete(id string) error {
+// DeleteMonitorGroup removes a monitor group
+func (c *Client) DeleteMonitorGroup(ctx context.Context, id string) error {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/monitor_groups/%s", c.APIBaseURL, id),
 		Method:   "DELETE",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return err
 	}
 	if res.Message != "success" {
-		return fmt.Errorf("[api.MonitorGroupDelete] API Response error; %s", res.Message)
+		return fmt.Errorf("[api.DeleteMonitorGroup] API Response error; %s", res.Message)
 	}
 
 	return nil
 }
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// MonitorGroupList returns Client.MonitorGroups as json for the current profile
+func MonitorGroupList(ctx context.Context, withSubgroups bool) (json.RawMessage, error) {
+	return encode(defaultClient().MonitorGroups(ctx, withSubgroups))
+}
+
+// MonitorGroupCreate returns Client.CreateMonitorGroup as json for the current profile
+func MonitorGroupCreate(ctx context.Context, mg *MonitorGroup) (json.RawMessage, error) {
+	return encode(defaultClient().CreateMonitorGroup(ctx, mg))
+}
+
+// MonitorGroupGet returns Client.MonitorGroup as json for the current profile
+func MonitorGroupGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().MonitorGroup(ctx, id))
+}
+
+// MonitorGroupUpdate returns Client.UpdateMonitorGroup as json for the current profile
+func MonitorGroupUpdate(ctx context.Context, mg *MonitorGroup) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateMonitorGroup(ctx, mg))
+}
+
+// MonitorGroupDelete is Client.DeleteMonitorGroup for the current profile
+func MonitorGroupDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteMonitorGroup(ctx, id)
+}
@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// NotificationProfile contains the data returned from any request for
+// notification profile information. A notification profile determines who is
+// alerted about a monitor, when, and how alerts are escalated.
+type NotificationProfile struct {
+	ID                          string   `json:"profile_id"`
+	Name                        string   `json:"profile_name"`
+	RCANeeded                   bool     `json:"rca_needed"`
+	NotifyAfterExecutingActions bool     `json:"notify_after_executing_actions"`
+	DowntimeNotificationDelay   int      `json:"downtime_notification_delay,omitempty"`
+	PersistentNotification      int      `json:"persistent_notification,omitempty"`
+	EscalationUserGroup         string   `json:"escalation_user_group_id,omitempty"`
+	EscalationWaitTime          int      `json:"escalation_wait_time,omitempty"`
+	EscalationAutomations       []string `json:"escalation_automations,omitempty"`
+	EscalationServices          []string `json:"escalation_services,omitempty"`
+	SuppressAutomation          bool     `json:"suppress_automation"`
+	Template                    string   `json:"template_id,omitempty"`
+}
+
+// NotificationProfileRequestBody defines the HTTP request body structure
+type NotificationProfileRequestBody struct {
+	Name                        string   `json:"profile_name"`
+	RCANeeded                   bool     `json:"rca_needed"`
+	NotifyAfterExecutingActions bool     `json:"notify_after_executing_actions"`
+	DowntimeNotificationDelay   int      `json:"downtime_notification_delay,omitempty"`
+	PersistentNotification      int      `json:"persistent_notification,omitempty"`
+	EscalationUserGroup         string   `json:"escalation_user_group_id,omitempty"`
+	EscalationWaitTime          int      `json:"escalation_wait_time,omitempty"`
+	EscalationAutomations       []string `json:"escalation_automations,omitempty"`
+	EscalationServices          []string `json:"escalation_services,omitempty"`
+	SuppressAutomation          bool     `json:"suppress_automation"`
+	Template                    string   `json:"template_id,omitempty"`
+}
+
+// toRequestBody performs a struct conversion
+func (np *NotificationProfile) toRequestBody() []byte {
+	var b NotificationProfileRequestBody
+	tmp, _ := json.Marshal(np)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// NotificationProfiles returns all notification profiles
+// https://www.site24x7.com/help/api/#list-all-notification-profiles
+func (c *Client) NotificationProfiles(ctx context.Context) ([]NotificationProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/notification_profiles", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving notification profiles; message: %s", res.Message)
+	}
+
+	return decode[[]NotificationProfile](res.Data)
+}
+
+// CreateNotificationProfile establishes a new notification profile
+// https://www.site24x7.com/help/api/#create-notification-profile
+func (c *Client) CreateNotificationProfile(ctx context.Context, np *NotificationProfile) (*NotificationProfile, error) {
+	b := np.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/notification_profiles", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return nil, fmt.Errorf("[api.CreateNotificationProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*NotificationProfile](res.Data)
+}
+
+// NotificationProfile fetches a notification profile
+// https://www.site24x7.com/help/api/#retrieve-notification-profile
+func (c *Client) NotificationProfile(ctx context.Context, id string) (*NotificationProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/notification_profiles/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "notification profile not found"}
+	}
+
+	return decode[*NotificationProfile](res.Data)
+}
+
+// UpdateNotificationProfile updates a notification profile
+// https://www.site24x7.com/help/api/#update-notification-profile
+func (c *Client) UpdateNotificationProfile(ctx context.Context, np *NotificationProfile) (*NotificationProfile, error) {
+	b := np.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/notification_profiles/%s", c.APIBaseURL, np.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateNotificationProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*NotificationProfile](res.Data)
+}
+
+// DeleteNotificationProfile removes a notification profile
+// https://www.site24x7.com/help/api/#delete-notification-profile
+func (c *Client) DeleteNotificationProfile(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/notification_profiles/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteNotificationProfile] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// NotificationProfileList returns Client.NotificationProfiles as json for the current profile
+func NotificationProfileList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().NotificationProfiles(ctx))
+}
+
+// NotificationProfileCreate returns Client.CreateNotificationProfile as json for the current profile
+func NotificationProfileCreate(ctx context.Context, np *NotificationProfile) (json.RawMessage, error) {
+	return encode(defaultClient().CreateNotificationProfile(ctx, np))
+}
+
+// NotificationProfileGet returns Client.NotificationProfile as json for the current profile
+func NotificationProfileGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().NotificationProfile(ctx, id))
+}
+
+// NotificationProfileUpdate returns Client.UpdateNotificationProfile as json for the current profile
+func NotificationProfileUpdate(ctx context.Context, np *NotificationProfile) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateNotificationProfile(ctx, np))
+}
+
+// NotificationProfileDelete is Client.DeleteNotificationProfile for the current profile
+func NotificationProfileDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteNotificationProfile(ctx, id)
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Outage is a span of time during which a monitor was down (or, for some
+// types, in trouble)
+type Outage struct {
+	ID        string `json:"outage_id"`
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time,omitempty"` // empty while the outage is ongoing
+	Duration  string `json:"duration,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+	Type      int    `json:"type"` // https://www.site24x7.com/help/api/#status_constants
+}
+
+// MonitorOutages lists the outages of a single monitor
+type MonitorOutages struct {
+	MonitorID string   `json:"monitor_id"`
+	Name      string   `json:"display_name"`
+	Outages   []Outage `json:"outages"`
+}
+
+// OutageReport contains the data returned from a request for an outage report
+type OutageReport struct {
+	Details []MonitorOutages `json:"outage_details"`
+}
+
+// OutageReport returns the outages over a period, of every monitor or, when
+// either identifier is given, of a monitor or the monitors of a monitor group
+// https://www.site24x7.com/help/api/#outage-report
+func (c *Client) OutageReport(ctx context.Context, p Period, monitorID string, groupID string) (*OutageReport, error) {
+	endpoint := fmt.Sprintf("%s/reports/outage", c.APIBaseURL)
+	switch {
+	case monitorID != "":
+		endpoint += "/" + monitorID
+	case groupID != "":
+		endpoint += "/group/" + groupID
+	}
+
+	req := Request{
+		Endpoint: endpoint,
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:        nil,
+		QueryString: p.query(),
+		client:      c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving outage report; message: %s", res.Message)
+	}
+
+	return decode[*OutageReport](res.Data)
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// OutageReportGet returns Client.OutageReport as json for the current profile
+func OutageReportGet(ctx context.Context, p Period, monitorID string, groupID string) (json.RawMessage, error) {
+	return encode(defaultClient().OutageReport(ctx, p, monitorID, groupID))
+}
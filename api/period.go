@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// PeriodCustom is the report period constant for a span between two dates
+// https://www.site24x7.com/help/api/#time_period_constants
+const PeriodCustom = 4
+
+// TimeLayout is the layout of the times in API requests and responses, e.g.
+// the start and end of a custom period or of an outage
+const TimeLayout = "2006-01-02T15:04:05-0700"
+
+// Period selects the span of time covered by a report or history: one of
+// Site24x7's report periods, e.g. the last 7 days, or a custom span. Start
+// and End are only sent for a custom period, but callers may fill them in for
+// any period to filter results locally.
+// https://www.site24x7.com/help/api/#time_period_constants
+type Period struct {
+	Code  int
+	Start time.Time
+	End   time.Time
+}
+
+// query returns the query string parameters that select the period
+func (p Period) query() url.Values {
+	q := url.Values{"period": {strconv.Itoa(p.Code)}}
+	if p.Code == PeriodCustom {
+		q.Set("start_date", p.Start.Format(TimeLayout))
+		q.Set("end_date", p.End.Format(TimeLayout))
+	}
+
+	return q
+}
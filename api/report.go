@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReportInfo describes the subject and span of a report
+type ReportInfo struct {
+	ResourceID   string `json:"resource_id"`
+	ResourceName string `json:"resource_name"`
+	StartTime    string `json:"start_time"`
+	EndTime      string `json:"end_time"`
+	PeriodName   string `json:"period_name,omitempty"`
+}
+
+// AvailabilitySummary summarizes the availability of a monitor over a period
+type AvailabilitySummary struct {
+	AvailabilityPercentage float64 `json:"availability_percentage"`
+	DowntimePercentage     float64 `json:"downtime_percentage"`
+	MaintenancePercentage  float64 `json:"maintenance_percentage"`
+	DowntimeDuration       string  `json:"downtime_duration,omitempty"`
+	DownCount              int     `json:"down_count"`
+	MTTR                   string  `json:"mttr,omitempty"` // mean time to repair
+	MTBF                   string  `json:"mtbf,omitempty"` // mean time between failures
+}
+
+// AvailabilityReport contains the data returned from a request for an
+// availability summary report
+type AvailabilityReport struct {
+	Info    ReportInfo          `json:"info"`
+	Summary AvailabilitySummary `json:"summary_details"`
+}
+
+// PerformanceSummary summarizes the response times of a monitor over a period
+type PerformanceSummary struct {
+	AverageResponseTime float64 `json:"average_response_time"`
+	MinimumResponseTime float64 `json:"min_response_time"`
+	MaximumResponseTime float64 `json:"max_response_time"`
+	Percentile95        float64 `json:"95_percentile_response_time,omitempty"`
+	Unit                string  `json:"unit,omitempty"` // e.g. ms
+}
+
+// PerformanceReport contains the data returned from a request for a
+// performance report
+type PerformanceReport struct {
+	Info    ReportInfo         `json:"info"`
+	Summary PerformanceSummary `json:"summary_details"`
+}
+
+// AvailabilityReport returns a monitor's availability summary report
+// https://www.site24x7.com/help/api/#availability-summary-report
+func (c *Client) AvailabilityReport(ctx context.Context, monitorID string, p Period) (*AvailabilityReport, error) {
+	data, err := c.report(ctx, "availability_summary", monitorID, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[*AvailabilityReport](data)
+}
+
+// PerformanceReport returns a monitor's performance report
+// https://www.site24x7.com/help/api/#performance-report
+func (c *Client) PerformanceReport(ctx context.Context, monitorID string, p Period) (*PerformanceReport, error) {
+	data, err := c.report(ctx, "performance", monitorID, p)
+	if err != nil {
+		return nil, err
+	}
+
+	return decode[*PerformanceReport](data)
+}
+
+// report fetches a kind of report about a monitor over a period
+func (c *Client) report(ctx context.Context, kind string, monitorID string, p Period) (json.RawMessage, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/reports/%s/%s", c.APIBaseURL, kind, monitorID),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:        nil,
+		QueryString: p.query(),
+		client:      c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil || string(res.Data) == "{}" {
+		return nil, fmt.Errorf("Error retrieving %s report; message: %s", kind, res.Message)
+	}
+
+	return res.Data, nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// AvailabilitySummaryGet returns Client.AvailabilityReport as json for the current profile
+func AvailabilitySummaryGet(ctx context.Context, monitorID string, p Period) (json.RawMessage, error) {
+	return encode(defaultClient().AvailabilityReport(ctx, monitorID, p))
+}
+
+// PerformanceReportGet returns Client.PerformanceReport as json for the current profile
+func PerformanceReportGet(ctx context.Context, monitorID string, p Period) (json.RawMessage, error) {
+	return encode(defaultClient().PerformanceReport(ctx, monitorID, p))
+}
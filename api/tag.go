@@ -0,0 +1,189 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// Tag contains the data returned from any request for tag information. A tag
+// is a name and, optionally, a value, e.g. env=prod, that's attached to
+// monitors and monitor groups so they can be operated on as a set.
+// https://www.site24x7.com/help/api/#tags
+type Tag struct {
+	ID    string `json:"tag_id"`
+	Name  string `json:"tag_name"`
+	Value string `json:"tag_value,omitempty"`
+	Color string `json:"tag_color,omitempty"` // e.g. #B7DA9E
+	Type  int    `json:"tag_type,omitempty"`  // https://www.site24x7.com/help/api/#tag_type_constants
+}
+
+// TagRequestBody defines the HTTP request body structure
+type TagRequestBody struct {
+	Name  string `json:"tag_name"`
+	Value string `json:"tag_value,omitempty"`
+	Color string `json:"tag_color,omitempty"`
+}
+
+// toRequestBody performs a struct conversion
+func (t *Tag) toRequestBody() []byte {
+	var b TagRequestBody
+	tmp, _ := json.Marshal(t)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// Tags returns all tags
+// https://www.site24x7.com/help/api/#list-tags
+func (c *Client) Tags(ctx context.Context) ([]Tag, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/tags", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving tags; message: %s", res.Message)
+	}
+
+	return decode[[]Tag](res.Data)
+}
+
+// CreateTag establishes a new tag
+// https://www.site24x7.com/help/api/#create-tag
+func (c *Client) CreateTag(ctx context.Context, t *Tag) (*Tag, error) {
+	b := t.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/tags", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return nil, fmt.Errorf("[api.CreateTag] API Response error; %s", res.Message)
+	}
+
+	return decode[*Tag](res.Data)
+}
+
+// Tag fetches a tag
+// https://www.site24x7.com/help/api/#retrieve-tag
+func (c *Client) Tag(ctx context.Context, id string) (*Tag, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/tags/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "tag not found"}
+	}
+
+	return decode[*Tag](res.Data)
+}
+
+// UpdateTag updates a tag
+// https://www.site24x7.com/help/api/#update-tag
+func (c *Client) UpdateTag(ctx context.Context, t *Tag) (*Tag, error) {
+	b := t.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/tags/%s", c.APIBaseURL, t.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateTag] API Response error; %s", res.Message)
+	}
+
+	return decode[*Tag](res.Data)
+}
+
+// DeleteTag removes a tag
+// https://www.site24x7.com/help/api/#delete-tag
+func (c *Client) DeleteTag(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/tags/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteTag] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// TagList returns Client.Tags as json for the current profile
+func TagList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().Tags(ctx))
+}
+
+// TagCreate returns Client.CreateTag as json for the current profile
+func TagCreate(ctx context.Context, t *Tag) (json.RawMessage, error) {
+	return encode(defaultClient().CreateTag(ctx, t))
+}
+
+// TagGet returns Client.Tag as json for the current profile
+func TagGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().Tag(ctx, id))
+}
+
+// TagUpdate returns Client.UpdateTag as json for the current profile
+func TagUpdate(ctx context.Context, t *Tag) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateTag(ctx, t))
+}
+
+// TagDelete is Client.DeleteTag for the current profile
+func TagDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteTag(ctx, id)
+}
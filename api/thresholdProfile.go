@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"site24x7/logger"
+)
+
+// ThresholdCondition defines when a measurement should raise an alert
+// https://www.site24x7.com/help/api/#threshold_strategy_constants
+type ThresholdCondition struct {
+	Strategy           int `json:"strategy"`            // https://www.site24x7.com/help/api/#threshold_strategy_constants
+	ComparisonOperator int `json:"comparison_operator"` // https://www.site24x7.com/help/api/#comparison_operator_constants
+	Value              int `json:"value"`
+	PollsCheck         int `json:"polls_check,omitempty"`
+}
+
+// ThresholdProfile contains the data returned from any request for threshold
+// and availability profile information.
+type ThresholdProfile struct {
+	ID                                    string              `json:"profile_id"`
+	Name                                  string              `json:"profile_name"`
+	Type                                  string              `json:"type"`         // https://www.site24x7.com/help/api/#monitor-types
+	ProfileType                           int                 `json:"profile_type"` // 1 (Static) or 2 (AI-based)
+	DownLocationThreshold                 int                 `json:"down_location_threshold"`
+	WebsiteContentModified                bool                `json:"website_content_modified"`
+	PrimaryResponseTimeTroubleThreshold   *ThresholdCondition `json:"primary_response_time_trouble_threshold,omitempty"`
+	SecondaryResponseTimeTroubleThreshold *ThresholdCondition `json:"secondary_response_time_trouble_threshold,omitempty"`
+}
+
+// ThresholdProfileRequestBody defines the HTTP request body structure
+type ThresholdProfileRequestBody struct {
+	Name                                  string              `json:"profile_name"`
+	Type                                  string              `json:"type"`
+	ProfileType                           int                 `json:"profile_type"`
+	DownLocationThreshold                 int                 `json:"down_location_threshold"`
+	WebsiteContentModified                bool                `json:"website_content_modified"`
+	PrimaryResponseTimeTroubleThreshold   *ThresholdCondition `json:"primary_response_time_trouble_threshold,omitempty"`
+	SecondaryResponseTimeTroubleThreshold *ThresholdCondition `json:"secondary_response_time_trouble_threshold,omitempty"`
+}
+
+// toRequestBody performs a struct conversion
+func (tp *ThresholdProfile) toRequestBody() []byte {
+	var b ThresholdProfileRequestBody
+	tmp, _ := json.Marshal(tp)
+	json.Unmarshal(tmp, &b)
+	body, _ := json.Marshal(b)
+
+	return body
+}
+
+// ThresholdProfiles returns all threshold profiles
+// https://www.site24x7.com/help/api/#list-all-threshold-profiles
+func (c *Client) ThresholdProfiles(ctx context.Context) ([]ThresholdProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/threshold_profiles", c.APIBaseURL),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Message != "success" || res.Data == nil {
+		return nil, fmt.Errorf("Error retrieving threshold profiles; message: %s", res.Message)
+	}
+
+	return decode[[]ThresholdProfile](res.Data)
+}
+
+// CreateThresholdProfile establishes a new threshold profile
+// https://www.site24x7.com/help/api/#create-threshold-profile
+func (c *Client) CreateThresholdProfile(ctx context.Context, tp *ThresholdProfile) (*ThresholdProfile, error) {
+	b := tp.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/threshold_profiles", c.APIBaseURL),
+		Method:   "POST",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		logger.Debug(fmt.Sprintf("Response\n%+v", res))
+		return nil, fmt.Errorf("[api.CreateThresholdProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*ThresholdProfile](res.Data)
+}
+
+// ThresholdProfile fetches a threshold profile
+// https://www.site24x7.com/help/api/#retrieve-threshold-profile
+func (c *Client) ThresholdProfile(ctx context.Context, id string) (*ThresholdProfile, error) {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/threshold_profiles/%s", c.APIBaseURL, id),
+		Method:   "GET",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Data == nil || string(res.Data) == "{}" {
+		// Handle a "known" error just a little bit more cleanly
+		return nil, &NotFoundError{Message: "threshold profile not found"}
+	}
+
+	return decode[*ThresholdProfile](res.Data)
+}
+
+// UpdateThresholdProfile updates a threshold profile
+// https://www.site24x7.com/help/api/#update-threshold-profile
+func (c *Client) UpdateThresholdProfile(ctx context.Context, tp *ThresholdProfile) (*ThresholdProfile, error) {
+	b := tp.toRequestBody()
+
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/threshold_profiles/%s", c.APIBaseURL, tp.ID),
+		Method:   "PUT",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   b,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if res.Data == nil || string(res.Data) == "{}" || res.Message != "success" {
+		return nil, fmt.Errorf("[api.UpdateThresholdProfile] API Response error; %s", res.Message)
+	}
+
+	return decode[*ThresholdProfile](res.Data)
+}
+
+// DeleteThresholdProfile removes a threshold profile
+// https://www.site24x7.com/help/api/#delete-threshold-profile
+func (c *Client) DeleteThresholdProfile(ctx context.Context, id string) error {
+	req := Request{
+		Endpoint: fmt.Sprintf("%s/threshold_profiles/%s", c.APIBaseURL, id),
+		Method:   "DELETE",
+		Headers: http.Header{
+			"Accept": {"application/json; version=2.0"},
+		},
+		Body:   nil,
+		client: c,
+	}
+	res, err := req.Fetch(ctx)
+	if err != nil {
+		return err
+	}
+	if res.Message != "success" {
+		return fmt.Errorf("[api.DeleteThresholdProfile] API Response error; %s", res.Message)
+	}
+
+	return nil
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// ThresholdProfileList returns Client.ThresholdProfiles as json for the current profile
+func ThresholdProfileList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().ThresholdProfiles(ctx))
+}
+
+// ThresholdProfileCreate returns Client.CreateThresholdProfile as json for the current profile
+func ThresholdProfileCreate(ctx context.Context, tp *ThresholdProfile) (json.RawMessage, error) {
+	return encode(defaultClient().CreateThresholdProfile(ctx, tp))
+}
+
+// ThresholdProfileGet returns Client.ThresholdProfile as json for the current profile
+func ThresholdProfileGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().ThresholdProfile(ctx, id))
+}
+
+// ThresholdProfileUpdate returns Client.UpdateThresholdProfile as json for the current profile
+func ThresholdProfileUpdate(ctx context.Context, tp *ThresholdProfile) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateThresholdProfile(ctx, tp))
+}
+
+// ThresholdProfileDelete is Client.DeleteThresholdProfile for the current profile
+func ThresholdProfileDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteThresholdProfile(ctx, id)
+}
@@ -0,0 +1,117 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"site24x7/config"
+	"site24x7/logger"
+	"time"
+)
+
+// tokenExpiryMargin is how long before its actual expiry that a cached access
+// token stops being used; a token shouldn't expire mid-command.
+const tokenExpiryMargin = 5 * time.Minute
+
+// cachedToken is an access token as it's persisted between commands
+type cachedToken struct {
+	AuthToken
+	ExpiresAt time.Time `json:"expires_at"`
+	// Fingerprint identifies the credentials that produced the token so that
+	// reconfiguring a profile invalidates its cached token
+	Fingerprint string `json:"fingerprint"`
+}
+
+// valid reports whether a cached token can still be used
+func (t *cachedToken) valid() bool {
+	return t.AccessToken != "" &&
+		t.Fingerprint == credentialsFingerprint() &&
+		time.Now().Add(tokenExpiryMargin).Before(t.ExpiresAt)
+}
+
+// credentialsFingerprint hashes the credentials of the current profile
+func credentialsFingerprint() string {
+	h := sha256.New()
+	for _, v := range []string{
+		authBaseURL(),
+		config.GetString("auth.client_id"),
+		config.GetString("auth.refresh_token"),
+	} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// tokenCachePath returns the location of a profile's cached access token
+func tokenCachePath(profile string) (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, "site24x7", "tokens", url.PathEscape(profile)+".json"), nil
+}
+
+// readCachedToken returns the current profile's cached access token, if any
+func readCachedToken() *cachedToken {
+	f, err := tokenCachePath(config.Profile())
+	if err != nil {
+		return nil
+	}
+
+	b, err := os.ReadFile(f)
+	if err != nil {
+		return nil
+	}
+
+	var t cachedToken
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil
+	}
+
+	return &t
+}
+
+// writeCachedToken persists an access token for the current profile
+func writeCachedToken(t *AuthToken) error {
+	f, err := tokenCachePath(config.Profile())
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f), 0700); err != nil {
+		return err
+	}
+
+	ct := cachedToken{
+		AuthToken:   *t,
+		ExpiresAt:   time.Now().Add(time.Duration(t.ExpiresIn) * time.Second),
+		Fingerprint: credentialsFingerprint(),
+	}
+	// Never persist a refresh token alongside the access token
+	ct.RefreshToken = ""
+
+	b, _ := json.Marshal(ct)
+
+	return os.WriteFile(f, b, 0600)
+}
+
+// ClearTokenCache removes a profile's cached access token
+func ClearTokenCache(profile string) error {
+	f, err := tokenCachePath(profile)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(f); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("[api.ClearTokenCache] Unable to remove %s (%s)", f, err)
+	}
+
+	logger.Debug(fmt.Sprintf("[api.ClearTokenCache] Removed %s", f))
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// TokenSource supplies the access tokens that authenticate API requests
+type TokenSource interface {
+	// Token returns the current access token
+	Token(ctx context.Context) (string, error)
+	// Refresh replaces an access token that the API has rejected
+	Refresh(ctx context.Context) (string, error)
+}
+
+// StaticToken is a TokenSource for an access token that's obtained and renewed
+// elsewhere
+type StaticToken string
+
+// Token returns the access token
+func (t StaticToken) Token(ctx context.Context) (string, error) {
+	return string(t), nil
+}
+
+// Refresh fails; a static token can't be replaced
+func (t StaticToken) Refresh(ctx context.Context) (string, error) {
+	return "", &UnauthorizedError{Message: "the access token was rejected and can't be refreshed"}
+}
+
+// RefreshTokenSource exchanges a refresh token for access tokens as they're
+// needed, reusing each until shortly before it expires. It's safe for
+// concurrent use.
+type RefreshTokenSource struct {
+	AuthBaseURL string // e.g. https://accounts.zoho.com
+	Credentials Credentials
+	HTTPClient  *http.Client
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+// NewRefreshTokenSource returns a source of access tokens for credentials
+// that were issued by the Zoho accounts server at a base URL, usually that of
+// a DataCenter. A nil http.Client is replaced by one with the default timeout.
+func NewRefreshTokenSource(authBaseURL string, creds Credentials, hc *http.Client) *RefreshTokenSource {
+	if hc == nil {
+		hc = &http.Client{Timeout: DefaultTimeout}
+	}
+
+	return &RefreshTokenSource{AuthBaseURL: authBaseURL, Credentials: creds, HTTPClient: hc}
+}
+
+// Token returns the current access token, exchanging the refresh token for a
+// new one if there isn't one or it's about to expire
+func (s *RefreshTokenSource) Token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Now().Add(tokenExpiryMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+
+	return s.refresh(ctx)
+}
+
+// Refresh exchanges the refresh token for a new access token
+func (s *RefreshTokenSource) Refresh(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.refresh(ctx)
+}
+
+// refresh exchanges the refresh token; the caller holds the lock
+func (s *RefreshTokenSource) refresh(ctx context.Context) (string, error) {
+	t, err := exchangeToken(ctx, s.HTTPClient, s.AuthBaseURL, s.Credentials, map[string]string{
+		"grantType": "refresh_token",
+		"key":       "refresh_token",
+		"value":     s.Credentials.RefreshToken,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.token = t.AccessToken
+	s.expiresAt = time.Now().Add(time.Duration(t.ExpiresIn) * time.Second)
+
+	return s.token, nil
+}
+
+// profileTokenSource supplies the access token of the current configuration
+// profile, which Authenticate establishes before each command
+type profileTokenSource struct {
+	token string
+}
+
+// profileTokens authenticates the requests of the CLI's client
+var profileTokens = &profileTokenSource{}
+
+// Token returns the profile's access token
+func (s *profileTokenSource) Token(ctx context.Context) (string, error) {
+	return s.token, nil
+}
+
+// Refresh exchanges the profile's refresh token for a new access token
+func (s *profileTokenSource) Refresh(ctx context.Context) (string, error) {
+	if err := refreshAccessToken(ctx); err != nil {
+		return "", err
+	}
+
+	return s.token, nil
+}
@@ -1,12 +1,11 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
 	"site24x7/logger"
-	"strings"
 )
 
 // AlertingPeriod sets the window of time during which alerts may be sent
@@ -55,25 +54,26 @@ type UserRequestBody struct {
 
 // User defines the user data returned by Site24x7's user endpoints
 type User struct {
-	ID                  string                 `json:"user_id"`
-	Name                string                 `json:"display_name"`
-	EmailAddress        string                 `json:"email_address"`
-	Role                int                    `json:"user_role"`
-	JobTitle            int                    `json:"job_title"`
-	AlertSettings       AlertSettings          `json:"alert_settings"`
-	MonitorGroups       []string               `json:"user_groups"`
-	NotificationMethods []int                  `json:"notify_medium"`
-	MobileSettings      MobileSettings         `json:"mobile_settings"`
-	StatusIQRole        int                    `json:"statusiq_role"`
-	CloudspendRole      int                    `json:"cloudspend_role"`
-	ResourceType        int                    `json:"selection_type"`
-	ImagePresent        bool                   `json:"image_present"`
-	TwitterSettings     map[string]interface{} `json:"twitter_settings"`
-	IsAccountContact    bool                   `json:"is_account_contact"`
-	IsInvited           bool                   `json:"is_invited"`
-	ImSettings          map[string]interface{} `json:"im_settings"`
-	IsEditAllowed       bool                   `json:"is_edit_allowed"`
-	Zuid                string                 `json:"zuid"`
+	ID                    string                 `json:"user_id"`
+	Name                  string                 `json:"display_name"`
+	EmailAddress          string                 `json:"email_address"`
+	Role                  int                    `json:"user_role"`
+	JobTitle              int                    `json:"job_title"`
+	AlertSettings         AlertSettings          `json:"alert_settings"`
+	MonitorGroups         []string               `json:"user_groups"`
+	NotificationMethods   []int                  `json:"notify_medium"`
+	MobileSettings        MobileSettings         `json:"mobile_settings"`
+	StatusIQRole          int                    `json:"statusiq_role"`
+	CloudspendRole        int                    `json:"cloudspend_role"`
+	ResourceType          int                    `json:"selection_type"`
+	ImagePresent          bool                   `json:"image_present"`
+	TwitterSettings       map[string]interface{} `json:"twitter_settings"`
+	IsAccountContact      bool                   `json:"is_account_contact"`
+	IsInvited             bool                   `json:"is_invited"`
+	ImSettings            map[string]interface{} `json:"im_settings"`
+	IsEditAllowed         bool                   `json:"is_edit_allowed"`
+	Zuid                  string                 `json:"zuid"`
+	ConsentForNonEUAlerts bool                   `json:"consent_for_non_eu_alerts"`
 }
 
 // toRequestBody performs a struct conversion
@@ -86,18 +86,18 @@ func (u *User) toRequestBody() []byte {
 	return body
 }
 
-// UserList returns all users on the account
-func UserList() (json.RawMessage, error) {
+// Users returns all users on the account
+func (c *Client) Users(ctx context.Context) ([]User, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/users", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/users", c.APIBaseURL),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,105 +106,128 @@ func UserList() (json.RawMessage, error) {
 		return nil, fmt.Errorf("Error retrieving users; message: %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[[]User](res.Data)
 }
 
-// UserCreate creates a new user account
-func UserCreate(u *User) (json.RawMessage, error) {
+// CreateUser creates a new user account
+func (c *Client) CreateUser(ctx context.Context, u *User) (*User, error) {
 	b := u.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/users", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/users", c.APIBaseURL),
 		Method:   "POST",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if res.Data == nil || res.Message != "success" {
 		logger.Debug(fmt.Sprintf("Response\n%+v", res))
-		if strings.HasPrefix(res.Message, "Email is already registered") {
-			// Handle a "known" error just a little bit more cleanly
-			return nil, &ConflictError{"a user with that email address already exists"}
-		}
-
-		return nil, fmt.Errorf("[User.Create] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.CreateUser] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*User](res.Data)
 }
 
-// UserGet fetches an account user
-func UserGet(id string) (json.RawMessage, error) {
+// User fetches an account user
+func (c *Client) User(ctx context.Context, id string) (*User, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/users/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/users/%s", c.APIBaseURL, id),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if res.Data == nil {
 		// Handle a "known" error just a little bit more cleanly
-		return nil, &NotFoundError{"user not found"}
+		return nil, &NotFoundError{Message: "user not found"}
 	}
 
-	return res.Data, nil
+	return decode[*User](res.Data)
 }
 
-// UserUpdate modifies an account user. https://www.site24x7.com/help/api/#update-user
-func UserUpdate(u *User) (json.RawMessage, error) {
+// UpdateUser modifies an account user. https://www.site24x7.com/help/api/#update-user
+func (c *Client) UpdateUser(ctx context.Context, u *User) (*User, error) {
 	b := u.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/users/%s", os.Getenv("API_BASE_URL"), u.ID),
+		Endpoint: fmt.Sprintf("%s/users/%s", c.APIBaseURL, u.ID),
 		Method:   "PUT",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if res.Data == nil || res.Message != "success" {
-		return nil, fmt.Errorf("[User.Update] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.UpdateUser] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*User](res.Data)
 }
 
-// UserDelete removes a user from the account
-func UserDelete(id string) error {
+// DeleteUser removes a user from the account
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/users/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/users/%s", c.APIBaseURL, id),
 		Method:   "DELETE",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return err
 	}
 	if res.Message != "success" {
-		return fmt.Errorf("[UserDelete] API Response error; %s", res.Message)
+		return fmt.Errorf("[api.DeleteUser] API Response error; %s", res.Message)
 	}
 
 	return nil
 }
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// UserList returns Client.Users as json for the current profile
+func UserList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().Users(ctx))
+}
+
+// UserCreate returns Client.CreateUser as json for the current profile
+func UserCreate(ctx context.Context, u *User) (json.RawMessage, error) {
+	return encode(defaultClient().CreateUser(ctx, u))
+}
+
+// UserGet returns Client.User as json for the current profile
+func UserGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().User(ctx, id))
+}
+
+// UserUpdate returns Client.UpdateUser as json for the current profile
+func UserUpdate(ctx context.Context, u *User) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateUser(ctx, u))
+}
+
+// UserDelete is Client.DeleteUser for the current profile
+func UserDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteUser(ctx, id)
+}
@@ -1,10 +1,10 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"os"
 	"site24x7/logger"
 )
 
@@ -36,118 +36,118 @@ func (ug *UserGroup) toRequestBody() []byte {
 	return body
 }
 
-// UserGroupCreate establishes a new user group
+// CreateUserGroup establishes a new user group
 // https://www.site24x7.com/help/api/#create-user-group
-func UserGroupCreate(ug *UserGroup) (json.RawMessage, error) {
+func (c *Client) CreateUserGroup(ctx context.Context, ug *UserGroup) (*UserGroup, error) {
 	b := ug.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/user_groups", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/user_groups", c.APIBaseURL),
 		Method:   "POST",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if string(res.Data) == "{}" || res.Message != "success" {
 		logger.Debug(fmt.Sprintf("Response\n%+v", res))
 
-		return nil, fmt.Errorf("[api.UserGroupCreate] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.CreateUserGroup] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*UserGroup](res.Data)
 }
 
-// UserGroupGet fetches a monitor group
+// UserGroup fetches a monitor group
 // https://www.site24x7.com/help/api/#retrieve-user-group
-func UserGroupGet(id string) (json.RawMessage, error) {
+func (c *Client) UserGroup(ctx context.Context, id string) (*UserGroup, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/user_groups/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/user_groups/%s", c.APIBaseURL, id),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	if string(res.Data) == "{}" {
 		// Handle a "known" error just a little bit more cleanly
-		return nil, &NotFoundError{"user group not found"}
+		return nil, &NotFoundError{Message: "user group not found"}
 	}
 
-	return res.Data, nil
+	return decode[*UserGroup](res.Data)
 }
 
-// UserGroupUpdate updates a user group
+// UpdateUserGroup updates a user group
 // https://www.site24x7.com/help/api/#update-user-group
-func UserGroupUpdate(ug *UserGroup) (json.RawMessage, error) {
+func (c *Client) UpdateUserGroup(ctx context.Context, ug *UserGroup) (*UserGroup, error) {
 	b := ug.toRequestBody()
 
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/user_groups/%s", os.Getenv("API_BASE_URL"), ug.ID),
+		Endpoint: fmt.Sprintf("%s/user_groups/%s", c.APIBaseURL, ug.ID),
 		Method:   "PUT",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: b,
+		Body:   b,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
 	if string(res.Data) == "{}" || res.Message != "success" {
-		return nil, fmt.Errorf("[api.UserGroupUpdate] API Response error; %s", res.Message)
+		return nil, fmt.Errorf("[api.UpdateUserGroup] API Response error; %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[*UserGroup](res.Data)
 }
 
-// UserGroupDelete removes a user group
-func UserGroupDelete(id string) error {
+// DeleteUserGroup removes a user group
+func (c *Client) DeleteUserGroup(ctx context.Context, id string) error {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/user_groups/%s", os.Getenv("API_BASE_URL"), id),
+		Endpoint: fmt.Sprintf("%s/user_groups/%s", c.APIBaseURL, id),
 		Method:   "DELETE",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return err
 	}
 	if res.Message != "success" {
-		return fmt.Errorf("[api.UserGroupDelete] API Response error; %s", res.Message)
+		return fmt.Errorf("[api.DeleteUserGroup] API Response error; %s", res.Message)
 	}
 
 	return nil
 }
 
-// UserGroupList returns all monitor groups
+// UserGroups returns all monitor groups
 // https://www.site24x7.com/help/api/#list-of-all-user-groups
-func UserGroupList() (json.RawMessage, error) {
+func (c *Client) UserGroups(ctx context.Context) ([]UserGroup, error) {
 	req := Request{
-		Endpoint: fmt.Sprintf("%s/user_groups", os.Getenv("API_BASE_URL")),
+		Endpoint: fmt.Sprintf("%s/user_groups", c.APIBaseURL),
 		Method:   "GET",
 		Headers: http.Header{
 			"Accept": {"application/json; version=2.0"},
 		},
-		Body: nil,
+		Body:   nil,
+		client: c,
 	}
-	req.Headers.Set(httpHeader())
-	res, err := req.Fetch()
+	res, err := req.Fetch(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -156,5 +156,33 @@ func UserGroupList() (json.RawMessage, error) {
 		return nil, fmt.Errorf("Error retrieving user groups; message: %s", res.Message)
 	}
 
-	return res.Data, nil
+	return decode[[]UserGroup](res.Data)
+}
+
+// The functions below serve the CLI, which works with the account of the
+// current configuration profile and with json
+
+// UserGroupList returns Client.UserGroups as json for the current profile
+func UserGroupList(ctx context.Context) (json.RawMessage, error) {
+	return encode(defaultClient().UserGroups(ctx))
+}
+
+// UserGroupCreate returns Client.CreateUserGroup as json for the current profile
+func UserGroupCreate(ctx context.Context, ug *UserGroup) (json.RawMessage, error) {
+	return encode(defaultClient().CreateUserGroup(ctx, ug))
+}
+
+// UserGroupGet returns Client.UserGroup as json for the current profile
+func UserGroupGet(ctx context.Context, id string) (json.RawMessage, error) {
+	return encode(defaultClient().UserGroup(ctx, id))
+}
+
+// UserGroupUpdate returns Client.UpdateUserGroup as json for the current profile
+func UserGroupUpdate(ctx context.Context, ug *UserGroup) (json.RawMessage, error) {
+	return encode(defaultClient().UpdateUserGroup(ctx, ug))
+}
+
+// UserGroupDelete is Client.DeleteUserGroup for the current profile
+func UserGroupDelete(ctx context.Context, id string) error {
+	return defaultClient().DeleteUserGroup(ctx, id)
 }
@@ -0,0 +1,77 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/alertlog"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// alertsCmd represents the `alerts` command
+var alertsCmd = &cobra.Command{
+	Use:     "alerts <command>",
+	Short:   "Performs alert actions",
+	Long:    `Performs alert actions.`,
+	Aliases: []string{"alert"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any alerts command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// alertsLogCmd represents the `alerts log` subcommand
+var alertsLogCmd = &cobra.Command{
+	Use:   "log",
+	Short: "Retrieves the alerts sent over a period",
+	Long: `Retrieves the alerts sent over a period, oldest first, optionally only those
+about a monitor or the monitors of a monitor group (by name or ID), e.g.
+
+  site24x7 alerts log --monitor "Checkout API" --since 2021-06-01 --until 2021-06-03
+  site24x7 alerts log --group Production --period yesterday -o json
+
+Site24x7 lists alerts a day at a time, so a long period takes a request for
+each day.
+
+https://www.site24x7.com/help/api/#alert-logs`,
+	Aliases: []string{"logs", "ls"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := alertlog.List(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, alertlog.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(alertsCmd)
+	alertsCmd.AddCommand(alertsLogCmd)
+
+	// Flags for the `alerts log` command
+	alertsLogCmd.Flags().String("monitor", "", "Only list alerts about a monitor, by name or ID")
+	alertsLogCmd.Flags().String("group", "", "Only list alerts about the monitors of a monitor group, by name or ID")
+	alertsLogCmd.Flags().AddFlagSet(impl.GetPeriodFlags("last-24-hours"))
+}
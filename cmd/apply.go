@@ -0,0 +1,78 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl/manifest"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// applyCmd represents the `apply` command
+var applyCmd = &cobra.Command{
+	Use:   "apply -f <file|dir>",
+	Short: "Reconciles the account with a set of manifests",
+	Long: `Reconciles the account with a set of manifests, creating any object that
+doesn't exist and updating any that has drifted from its manifest.
+
+Manifests are YAML (.yaml, .yml) or json (.json) files, read recursively when
+a directory is given. Each names a kind of object -- LocationProfile,
+NotificationProfile, ThresholdProfile, User, UserGroup, MonitorGroup, Monitor
+or MaintenanceWindow -- and the properties it should have, using the property
+names of the Site24x7 API:
+
+  kind: MonitorGroup
+  spec:
+    display_name: Web servers
+    description: Production web servers
+    health_threshold_count: 1
+
+Objects are matched by identifier when the spec includes one, otherwise by
+email address (users), profile name (profiles) or display name. Properties that a manifest doesn't
+mention are left as they are. Use --dry-run to see what would be done.`,
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any apply command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("filename")
+		json, summary, err := manifest.Apply(cmd.Context(), path)
+		if json != nil {
+			if err := output.Render(cmd.Flags(), json, manifest.Table); err != nil {
+				return err
+			}
+			if format, _ := cmd.Flags().GetString("output"); format == output.DefaultFormat {
+				logger.Out("\n" + summary)
+			}
+		}
+
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(applyCmd)
+
+	applyCmd.Flags().StringP("filename", "f", "", "Manifest file, or directory of manifests, to apply")
+	applyCmd.MarkFlagRequired("filename")
+}
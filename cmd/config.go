@@ -20,11 +20,11 @@ import (
 	"fmt"
 	"os"
 	"site24x7/api"
+	"site24x7/config"
 	"site24x7/logger"
 	"strings"
 
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
 // configCmd represents the config command
@@ -35,7 +35,14 @@ var configCmd = &cobra.Command{
 
 Requests and stores authentication details that are required to access the
 Site24x7 API for a given account. This data is stored in a config file located
-at $HOME/<username>/.site24x7.yaml.`,
+at $HOME/<username>/.site24x7.yaml.
+
+Details for more than one account can be stored as named profiles. Pass
+--profile (or set SITE24X7_PROFILE) to configure, and later use, a profile
+other than the default.
+
+Each Site24x7 data center (US, EU, IN, AU, CN, JP, CA) has its own API and
+accounts hosts; the data center stored here determines which are used.`,
 	Aliases: []string{"configure", "cfg"},
 	PreRunE: func(cmd *cobra.Command, args []string) error {
 		updateRefreshTokenOnly, _ := cmd.Flags().GetBool("refresh-token")
@@ -46,11 +53,11 @@ at $HOME/<username>/.site24x7.yaml.`,
 		// TODO: Move work to impl package and test
 		var overwrite string
 
-		if f := viper.ConfigFileUsed(); f != "" {
+		if p := config.Profile(); config.Exists(p) {
 			if !updateRefreshTokenOnly {
-				fmt.Print("A config file already exists, do you want to overwrite it? [y/N]: ")
+				fmt.Printf("The %s profile already exists, do you want to overwrite it? [y/N]: ", p)
 			} else {
-				fmt.Print("A config file already exists, do you want to overwrite its refresh_token value? [y/N]: ")
+				fmt.Printf("The %s profile already exists, do you want to overwrite its refresh_token value? [y/N]: ", p)
 			}
 			fmt.Scanln(&overwrite)
 
@@ -68,12 +75,34 @@ at $HOME/<username>/.site24x7.yaml.`,
 		updateRefreshTokenOnly, _ := cmd.Flags().GetBool("refresh-token")
 
 		// TODO: Move work to impl package and test
+		var dataCenter string
 		var clientID string
 		var clientSecret string
 		var grantToken string
 
-		// Request the client id and secret
+		// Request the data center, client id and secret
 		if !updateRefreshTokenOnly {
+			// A bad configured value (or --data-center) shouldn't stop it
+			// being corrected here
+			current, err := api.CurrentDataCenter()
+			if err != nil {
+				logger.Warn(fmt.Sprintf("%s; defaulting to %s", err, api.DefaultDataCenter))
+				current, _ = api.LookupDataCenter(api.DefaultDataCenter)
+			}
+			fmt.Printf("Site24x7 Data Center (%s) [%s]: ", strings.Join(api.DataCenterCodes(), ", "), current.Code)
+			fmt.Scanln(&dataCenter)
+			if dataCenter == "" {
+				dataCenter = current.Code
+			}
+			dc, err := api.LookupDataCenter(dataCenter)
+			if err != nil {
+				return err
+			}
+			// The grant token exchange below has to happen in the same data
+			// center as the account, whatever --data-center might have said
+			dataCenter = dc.Code
+			config.Override("auth.data_center", dataCenter)
+
 			fmt.Print("Site24x7 Client ID [None]: ")
 			fmt.Scanln(&clientID)
 			fmt.Print("Site24x7 Client Secret [None]: ")
@@ -99,23 +128,138 @@ at $HOME/<username>/.site24x7.yaml.`,
 		// Update the client values if we're not dealing with a refresh token
 		// only call
 		if !updateRefreshTokenOnly {
-			viper.Set("auth.client_id", clientID)
-			viper.Set("auth.client_secret", clientSecret)
+			config.Set("auth.data_center", dataCenter)
+			config.Set("auth.client_id", clientID)
+			config.Set("auth.client_secret", clientSecret)
 		}
 
 		// Exchange the grant token for a refresh token
-		refreshToken, err := api.Configure(grantToken)
+		refreshToken, err := api.Configure(cmd.Context(), grantToken)
 		if err != nil {
 			logger.Warn("Unable to exchange the grant token provided for a refresh token.")
 			return fmt.Errorf("%s", err)
 		}
 
-		viper.Set("auth.refresh_token", refreshToken)
-		if err = viper.WriteConfig(); err != nil {
+		config.Set("auth.refresh_token", refreshToken)
+		if err = config.Save(); err != nil {
 			return fmt.Errorf("unable to complete configuration (%s)", err)
 		}
 
-		logger.Out("Configuration complete!")
+		// Any access token cached for the old credentials is now useless
+		if err = api.ClearTokenCache(config.Profile()); err != nil {
+			logger.Warn(err.Error())
+		}
+
+		logger.Out(fmt.Sprintf("Configuration of the %s profile complete!", config.Profile()))
+
+		return nil
+	},
+}
+
+// configListCmd represents the `config list` subcommand
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lists the configured profiles",
+	Long: `Lists the configured profiles.
+
+The profile used when neither --profile nor SITE24X7_PROFILE is set is marked
+with an asterisk.`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.SetVerbosity(cmd.Flags())
+
+		for _, p := range config.Profiles() {
+			marker := " "
+			if p == config.ActiveProfile() {
+				marker = "*"
+			}
+
+			dc := config.GetProfileString(p, "auth.data_center")
+			if dc == "" {
+				dc = api.DefaultDataCenter
+			}
+
+			logger.Out(fmt.Sprintf("%s %s (%s)", marker, p, strings.ToUpper(dc)))
+		}
+
+		return nil
+	},
+}
+
+// configUseCmd represents the `config use` subcommand
+var configUseCmd = &cobra.Command{
+	Use:   "use <profile>",
+	Short: "Selects the profile used by default",
+	Long: `Selects the profile used by default.
+
+Both --profile and SITE24X7_PROFILE take precedence over this selection.`,
+	Aliases: []string{"select", "switch"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.SetVerbosity(cmd.Flags())
+
+		name := args[0]
+		if err := config.Use(name); err != nil {
+			return err
+		}
+
+		logger.Out(fmt.Sprintf("Now using the %s profile", name))
+
+		return nil
+	},
+}
+
+// configDeleteCmd represents the `config delete` subcommand
+var configDeleteCmd = &cobra.Command{
+	Use:     "delete <profile>",
+	Short:   "Deletes a profile",
+	Long:    `Deletes a profile.`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.SetVerbosity(cmd.Flags())
+
+		name := args[0]
+		if !config.Exists(name) {
+			logger.Warn(fmt.Sprintf("profile %s does not exist", name))
+			return nil
+		}
+
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes {
+			var confirm string
+			fmt.Printf("Are you sure you want to delete the %s profile? [y/N]: ", name)
+			fmt.Scanln(&confirm)
+
+			if strings.ToUpper(confirm) != "Y" {
+				fmt.Println("No changes were made; exiting.")
+				return nil
+			}
+		}
+
+		if err := config.Delete(name); err != nil {
+			return err
+		}
+		if err := api.ClearTokenCache(name); err != nil {
+			logger.Warn(err.Error())
+		}
+
+		logger.Out("Profile successfully deleted!")
 
 		return nil
 	},
@@ -123,6 +267,9 @@ at $HOME/<username>/.site24x7.yaml.`,
 
 func init() {
 	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configListCmd)
+	configCmd.AddCommand(configUseCmd)
+	configCmd.AddCommand(configDeleteCmd)
 
 	// Here you will define your flags and configuration settings.
 
@@ -134,4 +281,7 @@ func init() {
 	// is called directly, e.g.:
 	// configCmd.Flags().BoolP("toggle", "t", false, "Help message for toggle")
 	configCmd.Flags().BoolP("refresh-token", "r", false, "Updates the refresh token only")
+
+	// Flags for the `config delete` command
+	configDeleteCmd.Flags().BoolP("yes", "y", false, "Deletes the profile without asking for confirmation")
 }
@@ -0,0 +1,98 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"site24x7/api/mock"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// devCmd represents the `dev` command
+var devCmd = &cobra.Command{
+	Use:   "dev <command>",
+	Short: "Tools for developing, testing and demonstrating the CLI",
+	Long:  `Tools for developing, testing and demonstrating the CLI.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// set the log verbosity for any dev command execution; none of them
+		// talk to Site24x7, so there's no need to authenticate
+		logger.SetVerbosity(cmd.Flags())
+	},
+}
+
+// devMockServerCmd represents the `dev mock-server` subcommand
+var devMockServerCmd = &cobra.Command{
+	Use:   "mock-server",
+	Short: "Runs a fake Site24x7 API locally",
+	Long: `Runs a fake Site24x7 API locally, until interrupted, for testing and demos
+without a real account. It implements the OAuth token endpoint and the users,
+user group and monitor group endpoints, and keeps everything in memory.
+
+Point the CLI at it with the API_BASE_URL and AUTH_BASE_URL environment
+variables, then configure a profile for it; any client ID, client secret and
+grant token are accepted, e.g.
+
+  export API_BASE_URL=http://localhost:8024/api AUTH_BASE_URL=http://localhost:8024
+  site24x7 config --profile mock
+  site24x7 user list --profile mock`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		addr, _ := cmd.Flags().GetString("listen")
+		empty, _ := cmd.Flags().GetBool("empty")
+
+		s := mock.New()
+		if !empty {
+			s.Seed()
+		}
+
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			return err
+		}
+		cmd.SilenceUsage = true
+
+		url := fmt.Sprintf("http://%s", l.Addr())
+		logger.Out(fmt.Sprintf("Mock Site24x7 API listening at %s; press Ctrl-C to stop", url))
+		logger.Out(fmt.Sprintf("  export API_BASE_URL=%s%s AUTH_BASE_URL=%s", url, mock.APIPath, url))
+
+		srv := &http.Server{Handler: s}
+		go func() {
+			<-cmd.Context().Done()
+			srv.Close()
+		}()
+
+		// Being interrupted is how the server is meant to stop
+		if err := srv.Serve(l); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(devCmd)
+	devCmd.AddCommand(devMockServerCmd)
+
+	devMockServerCmd.Flags().String("listen", "localhost:8024", "Address to listen on, e.g. :8024 or localhost:0 for any free port")
+	devMockServerCmd.Flags().Bool("empty", false, "Start with no users or groups rather than a small demo account")
+}
@@ -0,0 +1,66 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl/manifest"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// diffCmd represents the `diff` command
+var diffCmd = &cobra.Command{
+	Use:   "diff -f <file|dir>",
+	Short: "Shows how the account differs from a set of manifests",
+	Long: `Shows, property by property, how the account differs from a set of
+manifests: what "apply" would create and update. See "site24x7 apply --help"
+for a description of manifests.
+
+Exits with status 2 when any object differs from its manifest, so that drift
+can be caught in CI, and with another non-zero status when the account can't
+be compared with the manifests.`,
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any diff command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		path, _ := cmd.Flags().GetString("filename")
+		json, text, err := manifest.Diff(cmd.Context(), path, output.Color())
+		if json != nil {
+			if format, _ := cmd.Flags().GetString("output"); format == output.DefaultFormat {
+				logger.Out(text)
+			} else if err := output.Render(cmd.Flags(), json, manifest.Table); err != nil {
+				return err
+			}
+		}
+
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(diffCmd)
+
+	diffCmd.Flags().StringP("filename", "f", "", "Manifest file, or directory of manifests, to compare")
+	diffCmd.MarkFlagRequired("filename")
+}
@@ -0,0 +1,63 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl/manifest"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// exportCmd represents the `export` command
+var exportCmd = &cobra.Command{
+	Use:   "export --dir <dir>",
+	Short: "Exports the account to a directory of manifests",
+	Long: `Exports the account to a directory of manifests, one YAML file per
+object in a directory per kind, e.g. account/users/fred-example-com.yaml.
+Existing files are overwritten.
+
+Only properties that can be written are exported, so the directory can be
+checked into version control and applied with "site24x7 apply -f <dir>".`,
+	SilenceUsage: true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any export command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dir, _ := cmd.Flags().GetString("dir")
+		json, err := manifest.Export(cmd.Context(), dir)
+		if json != nil {
+			if err := output.Render(cmd.Flags(), json, manifest.ExportTable); err != nil {
+				return err
+			}
+		}
+
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(exportCmd)
+
+	exportCmd.Flags().StringP("dir", "d", "", "Directory to which manifests are written")
+	exportCmd.MarkFlagRequired("dir")
+}
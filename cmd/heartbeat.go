@@ -0,0 +1,287 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/heartbeat"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// heartbeatCmd represents the `heartbeat` command
+var heartbeatCmd = &cobra.Command{
+	Use:   "heartbeat <command>",
+	Short: "Performs heartbeat monitor actions",
+	Long: `Performs heartbeat monitor actions. A heartbeat monitor expects to be pinged,
+e.g. by a batch job each time it runs, and raises an alert when it isn't.
+Wherever a heartbeat ID is expected, its display name, or the name in its ping
+URL, may be given instead.`,
+	Aliases: []string{"hb", "heartbeats"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any heartbeat command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// heartbeatCreateCmd represents the `heartbeat create` subcommand
+var heartbeatCreateCmd = &cobra.Command{
+	Use:   "create <display name>",
+	Short: "Creates a new heartbeat monitor",
+	Long: `Creates a new heartbeat monitor, e.g.
+
+  site24x7 heartbeat create "Nightly Backup" --threshold-profile 123 --user-groups Operations
+
+https://www.site24x7.com/help/api/#create-monitor`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := heartbeat.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, heartbeat.Table)
+	},
+}
+
+// heartbeatGetCmd represents the `heartbeat get` subcommand
+var heartbeatGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific heartbeat monitor",
+	Long: `Retrieves a specific heartbeat monitor, including its ping URL.
+
+https://www.site24x7.com/help/api/#retrieve-monitor`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := heartbeat.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		j, err := heartbeat.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, heartbeat.Table)
+	},
+}
+
+// heartbeatUpdateCmd represents the `heartbeat update` subcommand
+var heartbeatUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing heartbeat monitor",
+	Long: `Updates an existing heartbeat monitor.
+
+https://www.site24x7.com/help/api/#update-monitor`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := heartbeat.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		json, err := heartbeat.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, heartbeat.Table)
+	},
+}
+
+// heartbeatDeleteCmd represents the `heartbeat delete` subcommand
+var heartbeatDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific heartbeat monitor",
+	Long: `Deletes a specific heartbeat monitor.
+
+https://www.site24x7.com/help/api/#delete-monitor`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := heartbeat.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := heartbeat.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Heartbeat", "deleted")
+
+		return nil
+	},
+}
+
+// heartbeatListCmd represents the `heartbeat list` subcommand
+var heartbeatListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all heartbeat monitors",
+	Long: `Retrieves a list of all heartbeat monitors.
+
+https://www.site24x7.com/help/api/#list-of-all-monitors`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := heartbeat.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, heartbeat.Table)
+	},
+}
+
+// heartbeatPingCmd represents the `heartbeat ping` subcommand
+var heartbeatPingCmd = &cobra.Command{
+	Use:   "ping [<id>]",
+	Short: "Pings a heartbeat monitor",
+	Long: `Pings a heartbeat monitor to report success or, given --fail, failure.
+Given the heartbeat's --url rather than its name or ID, no credentials are
+needed, e.g.
+
+  site24x7 heartbeat ping nightly-backup
+  site24x7 heartbeat ping --url "$HEARTBEAT_URL" --fail`,
+	Args: cobra.MaximumNArgs(1),
+	// authenticates only to look up a heartbeat by name
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		logger.SetVerbosity(cmd.Flags())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var ref string
+		if len(args) > 0 {
+			ref = args[0]
+		}
+		if err := heartbeat.Ping(cmd.Context(), ref, cmd.Flags()); err != nil {
+			return err
+		}
+
+		success("Heartbeat", "pinged")
+
+		return nil
+	},
+}
+
+// heartbeatWrapCmd represents the `heartbeat wrap` subcommand
+var heartbeatWrapCmd = &cobra.Command{
+	Use:   "wrap [<id>] -- <command> [<arg>...]",
+	Short: "Runs a command, reporting its start and end to a heartbeat monitor",
+	Long: `Runs a command, reporting its start and then its success or failure to a
+heartbeat monitor, given by name, ID or --url. The command's exit code is
+preserved, e.g.
+
+  site24x7 heartbeat wrap nightly-backup -- ./backup.sh --full
+  site24x7 heartbeat wrap --url "$HEARTBEAT_URL" -- make report
+
+A heartbeat that can't be looked up, e.g. while Site24x7 is unreachable or the
+credentials have expired, or a ping that fails, is warned about, but never stops
+the command from running.`,
+	// a failing command is no reason to show usage
+	SilenceUsage: true,
+	// authenticates only to look up a heartbeat by name, and carries on without
+	// the heartbeat if that fails
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		logger.SetVerbosity(cmd.Flags())
+	},
+	Args: func(cmd *cobra.Command, args []string) error {
+		dash := cmd.ArgsLenAtDash()
+		if dash < 0 {
+			return fmt.Errorf("expected -- before the command to run")
+		}
+		if dash > 1 {
+			return fmt.Errorf("expected at most 1 heartbeat before --, received %d", dash)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		dash := cmd.ArgsLenAtDash()
+		var ref string
+		if dash > 0 {
+			ref = args[0]
+		}
+
+		return heartbeat.Wrap(cmd.Context(), ref, cmd.Flags(), args[dash:])
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(heartbeatCmd)
+	heartbeatCmd.AddCommand(heartbeatCreateCmd)
+	heartbeatCmd.AddCommand(heartbeatGetCmd)
+	heartbeatCmd.AddCommand(heartbeatUpdateCmd)
+	heartbeatCmd.AddCommand(heartbeatDeleteCmd)
+	heartbeatCmd.AddCommand(heartbeatListCmd)
+	heartbeatCmd.AddCommand(heartbeatPingCmd)
+	heartbeatCmd.AddCommand(heartbeatWrapCmd)
+
+	// Flags for the `heartbeat create` command
+	heartbeatCreateCmd.Flags().AddFlagSet(heartbeat.GetWriterFlags())
+
+	// Flags for the `heartbeat update` command
+	heartbeatUpdateCmd.Flags().String("name", "", "New display name of the heartbeat")
+	heartbeatUpdateCmd.Flags().AddFlagSet(heartbeat.GetWriterFlags())
+
+	// Flags for the `heartbeat ping` command
+	heartbeatPingCmd.Flags().AddFlagSet(heartbeat.GetPingFlags())
+	heartbeatPingCmd.Flags().Bool("fail", false, "Report a failure rather than success")
+
+	// Flags for the `heartbeat wrap` command
+	heartbeatWrapCmd.Flags().AddFlagSet(heartbeat.GetPingFlags())
+}
@@ -0,0 +1,197 @@
+package alertlog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/logger"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiAlertLogList = api.AlertLogList
+var apiMonitorList = api.MonitorList
+var apiMonitorGroupGet = api.MonitorGroupGet
+var resolveMonitor = monitor.Resolve
+var resolveMonitorGroup = monitorgroup.Resolve
+
+// dateLayout is the layout of the date whose alerts are listed
+const dateLayout = "2006-01-02"
+
+// list returns the alerts sent on a date
+var list = func(ctx context.Context, date string) ([]api.AlertLog, error) {
+	data, err := apiAlertLogList(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	var logs []api.AlertLog
+	if err = json.Unmarshal(data, &logs); err != nil {
+		return nil, fmt.Errorf("[alertlog.list] Unable to  parse response data (%s)", err)
+	}
+
+	return logs, nil
+}
+
+// monitors returns the names of the monitors given by the --monitor and
+// --group flags, by ID, or nil when neither is given
+func monitors(ctx context.Context, fs *pflag.FlagSet) (map[string]string, error) {
+	monitorRef, _ := fs.GetString("monitor")
+	groupRef, _ := fs.GetString("group")
+	if monitorRef == "" && groupRef == "" {
+		return nil, nil
+	}
+
+	wanted := map[string]bool{}
+	if monitorRef != "" {
+		id, err := resolveMonitor(ctx, monitorRef)
+		if err != nil {
+			return nil, err
+		}
+		wanted[id] = true
+	}
+	if groupRef != "" {
+		id, err := resolveMonitorGroup(ctx, groupRef)
+		if err != nil {
+			return nil, err
+		}
+		data, err := apiMonitorGroupGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var mg api.MonitorGroup
+		if err := json.Unmarshal(data, &mg); err != nil {
+			return nil, fmt.Errorf("[alertlog.monitors] Unable to  parse response data (%s)", err)
+		}
+		for _, m := range mg.Monitors {
+			wanted[m] = true
+		}
+	}
+
+	data, err := apiMonitorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var all []api.Monitor
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("[alertlog.monitors] Unable to  parse response data (%s)", err)
+	}
+
+	names := map[string]string{}
+	for _, m := range all {
+		if wanted[m.ID] {
+			names[m.ID] = m.Name
+		}
+	}
+
+	return names, nil
+}
+
+// about reports whether an alert concerns one of a set of monitors. Alerts
+// that don't identify their monitor are matched by its display name or, failing
+// that, by its whole name in the message.
+func about(a api.AlertLog, monitors map[string]string) bool {
+	if a.MonitorID != "" {
+		_, ok := monitors[a.MonitorID]
+		return ok
+	}
+	for _, name := range monitors {
+		if name == "" {
+			continue
+		}
+		if a.MonitorName != "" {
+			if a.MonitorName == name {
+				return true
+			}
+			continue
+		}
+		if mentions(a.Message, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// mentions reports whether a message contains a monitor's name as a whole, so
+// that, e.g., "api" isn't found in "api-prod is down"
+func mentions(msg string, name string) bool {
+	for i := strings.Index(msg, name); i >= 0; {
+		end := i + len(name)
+		before, _ := utf8.DecodeLastRuneInString(msg[:i])
+		after, _ := utf8.DecodeRuneInString(msg[end:])
+		if (i == 0 || !inName(before)) && (end == len(msg) || !inName(after)) {
+			return true
+		}
+
+		next := strings.Index(msg[i+1:], name)
+		if next < 0 {
+			break
+		}
+		i += 1 + next
+	}
+
+	return false
+}
+
+// inName reports whether a character can continue a monitor's name
+func inName(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '-' || r == '_'
+}
+
+// List is the implementation of the `alerts log` command. Site24x7 lists alerts
+// a day at a time, so a request is made for each day of the period given by
+// the command's flags.
+func List(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
+	p, err := impl.ParsePeriod(fs)
+	if err != nil {
+		return nil, err
+	}
+	names, err := monitors(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []api.AlertLog{}
+	start := time.Date(p.Start.Year(), p.Start.Month(), p.Start.Day(), 0, 0, 0, 0, p.Start.Location())
+	for day := start; day.Before(p.End); day = day.AddDate(0, 0, 1) {
+		date := day.Format(dateLayout)
+		logger.Info(fmt.Sprintf("[alertlog.List] Fetching the alerts sent on %s", date))
+
+		alerts, err := list(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range alerts {
+			if sent, err := time.Parse(api.TimeLayout, a.SentTime); err == nil && (sent.Before(p.Start) || sent.After(p.End)) {
+				continue
+			}
+			if names != nil && !about(a, names) {
+				continue
+			}
+			logs = append(logs, a)
+		}
+	}
+
+	sort.SliceStable(logs, func(i, j int) bool {
+		a, _ := time.Parse(api.TimeLayout, logs[i].SentTime)
+		b, _ := time.Parse(api.TimeLayout, logs[j].SentTime)
+
+		return a.Before(b)
+	})
+
+	j, _ := json.MarshalIndent(logs, "", "    ")
+
+	return j, nil
+}
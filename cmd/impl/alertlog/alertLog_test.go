@@ -0,0 +1,128 @@
+package alertlog
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func getFlags(args ...string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+	fs.String("monitor", "", "")
+	fs.String("group", "", "")
+	fs.String("period", "last-24-hours", "")
+	fs.String("since", "", "")
+	fs.String("until", "", "")
+	fs.Parse(args)
+
+	return fs
+}
+
+func TestList(t *testing.T) {
+	var dates []string
+	apiAlertLogList = func(ctx context.Context, date string) (json.RawMessage, error) {
+		dates = append(dates, date)
+		switch date {
+		case "2021-06-01":
+			return []byte(`[
+				{"msg": "Web is down", "sent_time": "2021-06-01T23:00:00+0000", "monitor_id": "1"},
+				{"msg": "DNS is down", "sent_time": "2021-06-01T09:00:00+0000"}
+			]`), nil
+		case "2021-06-02":
+			return []byte(`[
+				{"msg": "Mail is down", "sent_time": "2021-06-02T08:00:00+0000", "monitor_id": "3"},
+				{"msg": "Web is up", "sent_time": "2021-06-02T20:00:00+0000", "monitor_id": "1"}
+			]`), nil
+		case "2021-06-03":
+			return []byte(`[
+				{"msg": "api-prod is down", "sent_time": "2021-06-03T08:00:00+0000"},
+				{"msg": "Checkout is down", "sent_time": "2021-06-03T09:00:00+0000", "display_name": "api-prod"},
+				{"msg": "Monitor api is down.", "sent_time": "2021-06-03T10:00:00+0000"}
+			]`), nil
+		}
+		return []byte(`[]`), nil
+	}
+	apiMonitorList = func(ctx context.Context) (json.RawMessage, error) {
+		return []byte(`[{"monitor_id": "1", "display_name": "Web"}, {"monitor_id": "2", "display_name": "DNS"}, {"monitor_id": "3", "display_name": "Mail"}, {"monitor_id": "4", "display_name": "api"}, {"monitor_id": "5", "display_name": "api-prod"}]`), nil
+	}
+	apiMonitorGroupGet = func(ctx context.Context, id string) (json.RawMessage, error) {
+		return []byte(`{"group_id": "10", "monitors": ["1", "2"]}`), nil
+	}
+	resolveMonitor = func(ctx context.Context, ref string) (string, error) {
+		return map[string]string{"Web": "1", "DNS": "2", "api": "4", "api-prod": "5"}[ref], nil
+	}
+	resolveMonitorGroup = func(ctx context.Context, ref string) (string, error) {
+		return "10", nil
+	}
+
+	span := []string{"--since", "2021-06-01T00:00:00Z", "--until", "2021-06-02T12:00:00Z"}
+	day := []string{"--since", "2021-06-03T00:00:00Z", "--until", "2021-06-03T23:00:00Z"}
+	tests := []struct {
+		name      string
+		args      []string
+		want      []string
+		wantDates []string
+	}{
+		{
+			name:      "Lists the alerts of each day in the period, oldest first",
+			args:      span,
+			want:      []string{"DNS is down", "Web is down", "Mail is down"},
+			wantDates: []string{"2021-06-01", "2021-06-02"},
+		},
+		{
+			name:      "Lists the alerts about a monitor",
+			args:      append([]string{"--monitor", "Web"}, span...),
+			want:      []string{"Web is down"},
+			wantDates: []string{"2021-06-01", "2021-06-02"},
+		},
+		{
+			name:      "Matches an alert without a monitor by name",
+			args:      append([]string{"--monitor", "DNS"}, span...),
+			want:      []string{"DNS is down"},
+			wantDates: []string{"2021-06-01", "2021-06-02"},
+		},
+		{
+			name:      "Doesn't match a monitor by part of another's name",
+			args:      append([]string{"--monitor", "api"}, day...),
+			want:      []string{"Monitor api is down."},
+			wantDates: []string{"2021-06-03"},
+		},
+		{
+			name:      "Matches an alert without a monitor by its display name",
+			args:      append([]string{"--monitor", "api-prod"}, day...),
+			want:      []string{"api-prod is down", "Checkout is down"},
+			wantDates: []string{"2021-06-03"},
+		},
+		{
+			name:      "Lists the alerts about a monitor group",
+			args:      append([]string{"--group", "Production"}, span...),
+			want:      []string{"DNS is down", "Web is down"},
+			wantDates: []string{"2021-06-01", "2021-06-02"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dates = nil
+			got, err := List(context.Background(), getFlags(tt.args...))
+			if err != nil {
+				t.Fatalf("List() error = %v", err)
+			}
+
+			var logs []map[string]interface{}
+			json.Unmarshal(got, &logs)
+			msgs := []string{}
+			for _, l := range logs {
+				msgs = append(msgs, l["msg"].(string))
+			}
+			if !reflect.DeepEqual(msgs, tt.want) {
+				t.Errorf("List() = %v, want %v", msgs, tt.want)
+			}
+			if !reflect.DeepEqual(dates, tt.wantDates) {
+				t.Errorf("List() fetched %v, want %v", dates, tt.wantDates)
+			}
+		})
+	}
+}
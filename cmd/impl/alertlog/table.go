@@ -0,0 +1,11 @@
+package alertlog
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for alert logs
+var Table = output.Table{
+	{Header: "SENT", Value: output.Field("sent_time")},
+	{Header: "MONITOR", Value: output.Field("display_name")},
+	{Header: "SENT BY", Value: output.Field("alert_type")},
+	{Header: "MESSAGE", Value: output.Field("msg")},
+}
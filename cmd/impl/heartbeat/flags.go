@@ -0,0 +1,26 @@
+package heartbeat
+
+import "github.com/spf13/pflag"
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.String("ping-name", "", "Name that identifies the heartbeat in its ping URL (default derived from the display name, e.g. nightly-backup)")
+	writerFlags.String("threshold-profile", "", "Identifier of the threshold profile that determines how often a ping is expected")
+	writerFlags.String("notification-profile", "", "Identifier of the notification profile that determines how alerts are sent")
+	writerFlags.StringSliceP("monitor-groups", "g", []string{}, "IDs or names of the monitor groups to which the heartbeat belongs")
+	writerFlags.StringSlice("user-groups", []string{}, "IDs or names of the user groups to be alerted")
+	writerFlags.StringSlice("tags", []string{}, "IDs or names of the tags to be associated with the heartbeat, e.g. env=prod")
+
+	return writerFlags
+}
+
+// GetPingFlags returns the flags of the commands that ping a heartbeat
+func GetPingFlags() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("ping", pflag.ExitOnError)
+	fs.String("url", "", "Ping URL of the heartbeat, which needs no credentials, rather than looking it up by name")
+
+	return fs
+}
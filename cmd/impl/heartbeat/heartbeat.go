@@ -0,0 +1,398 @@
+package heartbeat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/cmd/impl/tag"
+	"site24x7/cmd/impl/usergroup"
+	"site24x7/logger"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Type is the Site24x7 type code of a heartbeat monitor
+const Type = "HEARTBEAT"
+
+// Alias upstream functions for mocking
+
+var apiMonitorList = api.MonitorList
+var apiMonitorGet = api.MonitorGet
+var apiMonitorCreate = api.MonitorCreate
+var apiMonitorUpdate = api.MonitorUpdate
+var apiMonitorDelete = api.MonitorDelete
+var apiHeartbeatPing = api.HeartbeatPing
+var apiAuthenticate = api.Authenticate
+var resolveMonitorGroups = monitorgroup.ResolveAll
+var resolveUserGroups = usergroup.ResolveAll
+var resolveTags = tag.ResolveAll
+
+// pingTimeout bounds a ping that reports the end of a command that was
+// interrupted, once the command's own context is no longer usable
+var pingTimeout = 10 * time.Second
+
+// list returns a slice containing all heartbeat monitors on the account
+var list = func(ctx context.Context) ([]api.Monitor, error) {
+	data, err := apiMonitorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var monitors []api.Monitor
+	if err = json.Unmarshal(data, &monitors); err != nil {
+		return nil, fmt.Errorf("[heartbeat.list] Unable to  parse response data (%s)", err)
+	}
+
+	heartbeats := []api.Monitor{}
+	for _, m := range monitors {
+		if m.Type == Type {
+			heartbeats = append(heartbeats, m)
+		}
+	}
+
+	return heartbeats, nil
+}
+
+// get fetches a heartbeat monitor
+var get = func(ctx context.Context, id string) (*api.Monitor, error) {
+	var m api.Monitor
+
+	data, err := apiMonitorGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("[heartbeat.get] Unable to  parse response data (%s)", err)
+	}
+	if m.Type != Type {
+		return nil, fmt.Errorf("monitor (%s) is a %s monitor, not a heartbeat", id, m.Type)
+	}
+
+	return &m, nil
+}
+
+// resolver translates heartbeat names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a heartbeat's display name or
+// the name in its ping URL
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "heartbeat",
+		Command: "heartbeat list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			heartbeats, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(heartbeats))
+			for i, m := range heartbeats {
+				refs[i] = impl.Reference{ID: m.ID, Names: []string{m.Name, m.NameInPingURL}}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// Resolve translates a heartbeat's name (or ID) into its ID
+func Resolve(ctx context.Context, ref string) (string, error) {
+	return resolver.Resolve(ctx, ref)
+}
+
+// nonSlug matches the runs of characters that can't appear in the name in a
+// ping URL
+var nonSlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+// slug derives the name in a ping URL from a display name, e.g. "Nightly
+// Backup" becomes nightly-backup
+func slug(name string) string {
+	return strings.Trim(nonSlug.ReplaceAllString(strings.ToLower(name), "-"), "-")
+}
+
+// hydrate sets a heartbeat property from a flag
+func hydrate(m *api.Monitor, fs *pflag.FlagSet, f *pflag.Flag) {
+	switch f.Name {
+	case "ping-name":
+		m.NameInPingURL, _ = fs.GetString(f.Name)
+	case "threshold-profile":
+		m.ThresholdProfileID, _ = fs.GetString(f.Name)
+	case "notification-profile":
+		m.NotificationProfileID, _ = fs.GetString(f.Name)
+	case "monitor-groups":
+		m.MonitorGroups, _ = fs.GetStringSlice(f.Name)
+	case "user-groups":
+		m.UserGroups, _ = fs.GetStringSlice(f.Name)
+	case "tags":
+		m.Tags, _ = fs.GetStringSlice(f.Name)
+	}
+}
+
+// resolve replaces the group and tag names in a heartbeat with their IDs
+func resolve(ctx context.Context, m *api.Monitor) error {
+	var err error
+	if m.MonitorGroups, err = resolveMonitorGroups(ctx, m.MonitorGroups); err != nil {
+		return err
+	}
+	if m.UserGroups, err = resolveUserGroups(ctx, m.UserGroups); err != nil {
+		return err
+	}
+	if m.Tags, err = resolveTags(ctx, m.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create is the implementation of the `heartbeat create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	m := &api.Monitor{Name: name, Type: Type}
+	fs.VisitAll(func(f *pflag.Flag) {
+		hydrate(m, fs, f)
+	})
+	if m.NameInPingURL == "" {
+		m.NameInPingURL = slug(name)
+	}
+	if m.NameInPingURL == "" {
+		return nil, fmt.Errorf("a --ping-name is required when the display name has no letters or digits")
+	}
+
+	if err := resolve(ctx, m); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMonitorCreate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated monitor struct
+	var mon api.Monitor
+	if err = json.Unmarshal(data, &mon); err != nil {
+		return nil, fmt.Errorf("[heartbeat.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(mon, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `heartbeat get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	m, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(m, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `heartbeat update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[heartbeat.Update] Updating heartbeat with ID %s", id))
+
+	m, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[heartbeat.Update] Fetched heartbeat %+v", m))
+
+	// Hydrate the heartbeat, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		hydrate(m, fs, f)
+	})
+	if name, _ := fs.GetString("name"); name != "" {
+		m.Name = name
+	}
+
+	if err := resolve(ctx, m); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMonitorUpdate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated monitor struct
+	var mOut api.Monitor
+	if err = json.Unmarshal(data, &mOut); err != nil {
+		return nil, fmt.Errorf("[heartbeat.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(mOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `heartbeat delete` command
+func Delete(ctx context.Context, id string) error {
+	if _, err := get(ctx, id); err != nil {
+		return err
+	}
+
+	return apiMonitorDelete(ctx, id)
+}
+
+// List is the implementation of the `heartbeat list` command
+func List(ctx context.Context) ([]byte, error) {
+	heartbeats, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(heartbeats, "", "    ")
+
+	return j, nil
+}
+
+// requirePingTarget ensures that a heartbeat is given, either by reference or
+// by the --url flag
+func requirePingTarget(ref string, fs *pflag.FlagSet) error {
+	if u, _ := fs.GetString("url"); u == "" && ref == "" {
+		return fmt.Errorf("either a heartbeat or its --url is required")
+	}
+
+	return nil
+}
+
+// pingURL returns the URL given by the --url flag or, failing that, the ping
+// URL of the heartbeat that a reference names. Only a lookup by name needs
+// credentials, so it's only then that the command authenticates.
+func pingURL(ctx context.Context, ref string, fs *pflag.FlagSet) (string, error) {
+	if u, _ := fs.GetString("url"); u != "" {
+		return u, nil
+	}
+	if err := requirePingTarget(ref, fs); err != nil {
+		return "", err
+	}
+
+	if err := apiAuthenticate(ctx); err != nil {
+		return "", err
+	}
+	id, err := Resolve(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	m, err := get(ctx, id)
+	if err != nil {
+		return "", err
+	}
+	if m.PingURL == "" {
+		return "", fmt.Errorf("Site24x7 didn't provide a ping URL for heartbeat (%s); give it with --url", ref)
+	}
+
+	return m.PingURL, nil
+}
+
+// Ping is the implementation of the `heartbeat ping` command; it reports
+// success unless given --fail
+func Ping(ctx context.Context, ref string, fs *pflag.FlagSet) error {
+	u, err := pingURL(ctx, ref, fs)
+	if err != nil {
+		return err
+	}
+
+	signal := api.HeartbeatSuccess
+	if fail, _ := fs.GetBool("fail"); fail {
+		signal = api.HeartbeatFail
+	}
+
+	return apiHeartbeatPing(ctx, u, signal)
+}
+
+// Wrap is the implementation of the `heartbeat wrap` command. It reports the
+// start of a command to a heartbeat, runs the command, and then reports its
+// success or failure. A heartbeat that can't be looked up, or a ping that
+// fails, is only warned about, so that a monitoring problem never stops a job;
+// the command's own failure is returned as an error that wraps its
+// *exec.ExitError.
+func Wrap(ctx context.Context, ref string, fs *pflag.FlagSet, command []string) error {
+	if len(command) == 0 {
+		return fmt.Errorf("a command to run is required after --")
+	}
+	if err := requirePingTarget(ref, fs); err != nil {
+		return err
+	}
+
+	u, err := pingURL(ctx, ref, fs)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		logger.Warn(fmt.Sprintf("Running the command without reporting to the heartbeat (%s)", err))
+	}
+
+	// ping reports to the heartbeat, if it was found
+	ping := func(ctx context.Context, signal string, event string) {
+		if u == "" {
+			return
+		}
+		if err := apiHeartbeatPing(ctx, u, signal); err != nil {
+			logger.Warn(fmt.Sprintf("Unable to report the %s of the command (%s)", event, err))
+		}
+	}
+
+	ping(ctx, api.HeartbeatStart, "start")
+
+	logger.Info(fmt.Sprintf("[heartbeat.Wrap] Running %s", strings.Join(command, " ")))
+	c := exec.CommandContext(ctx, command[0], command[1:]...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	runErr := c.Run()
+
+	signal := api.HeartbeatSuccess
+	if runErr != nil {
+		signal = api.HeartbeatFail
+	}
+
+	// A command that was interrupted still failed, but the context can no
+	// longer carry the news
+	pingCtx := ctx
+	if ctx.Err() != nil {
+		var cancel context.CancelFunc
+		pingCtx, cancel = context.WithTimeout(context.Background(), pingTimeout)
+		defer cancel()
+	}
+	ping(pingCtx, signal, "end")
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	var exited *exec.ExitError
+	if errors.As(runErr, &exited) {
+		return &ExitError{Command: command[0], ExitError: exited}
+	}
+
+	return runErr
+}
+
+// ExitError reports that a wrapped command failed; its exit code is that of
+// the command
+type ExitError struct {
+	Command string
+	*exec.ExitError
+}
+
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("%s exited with status %d", e.Command, e.ExitCode())
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.ExitError
+}
@@ -0,0 +1,162 @@
+package heartbeat
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os/exec"
+	"reflect"
+	"site24x7/api"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// mockPings records the signals sent to each ping URL
+func mockPings() *[]string {
+	pings := []string{}
+	apiHeartbeatPing = func(ctx context.Context, pingURL string, signal string) error {
+		pings = append(pings, pingURL+"#"+signal)
+		return nil
+	}
+
+	return &pings
+}
+
+func getPingFlags(args ...string) *pflag.FlagSet {
+	fs := GetPingFlags()
+	fs.Bool("fail", false, "")
+	fs.Parse(args)
+
+	return fs
+}
+
+func TestCreate(t *testing.T) {
+	apiMonitorCreate = func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+		return json.Marshal(m)
+	}
+
+	tests := []struct {
+		name    string
+		display string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{name: "Derives the ping name", display: "Nightly Backup (EU)", want: "nightly-backup-eu"},
+		{name: "Takes a ping name", display: "Nightly Backup", args: []string{"--ping-name", "backup"}, want: "backup"},
+		{name: "Requires a usable ping name", display: "***", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			fs.Parse(tt.args)
+
+			got, err := Create(context.Background(), tt.display, fs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Create() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var m api.Monitor
+			json.Unmarshal(got, &m)
+			if m.Type != Type || m.NameInPingURL != tt.want {
+				t.Errorf("Create() = %s ping name %q, want %s ping name %q", m.Type, m.NameInPingURL, Type, tt.want)
+			}
+		})
+	}
+}
+
+func TestPing(t *testing.T) {
+	apiAuthenticate = func(ctx context.Context) error { return nil }
+	list = func(ctx context.Context) ([]api.Monitor, error) {
+		return []api.Monitor{{ID: "1", Name: "Nightly Backup", Type: Type, NameInPingURL: "nightly-backup"}}, nil
+	}
+	get = func(ctx context.Context, id string) (*api.Monitor, error) {
+		return &api.Monitor{ID: id, Type: Type, PingURL: "https://hb.example.com/key/nightly-backup"}, nil
+	}
+	resolver = newResolver()
+
+	tests := []struct {
+		name    string
+		ref     string
+		args    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "Pings a heartbeat by name", ref: "nightly-backup", want: []string{"https://hb.example.com/key/nightly-backup#"}},
+		{name: "Reports a failure", ref: "Nightly Backup", args: []string{"--fail"}, want: []string{"https://hb.example.com/key/nightly-backup#fail"}},
+		{name: "Pings a URL", args: []string{"--url", "https://hb.example.com/key/other"}, want: []string{"https://hb.example.com/key/other#"}},
+		{name: "Requires a heartbeat or URL", wantErr: true},
+		{name: "Rejects an unknown heartbeat", ref: "weekly", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pings := mockPings()
+			err := Ping(context.Background(), tt.ref, getPingFlags(tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Ping() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(*pings, tt.want) {
+				t.Errorf("Ping() sent %v, want %v", *pings, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap(t *testing.T) {
+	const u = "https://hb.example.com/key/job"
+
+	tests := []struct {
+		name     string
+		command  []string
+		want     []string
+		wantCode int
+	}{
+		{name: "Reports success", command: []string{"sh", "-c", "exit 0"}, want: []string{u + "#start", u + "#"}},
+		{name: "Reports failure with the exit code", command: []string{"sh", "-c", "exit 3"}, want: []string{u + "#start", u + "#fail"}, wantCode: 3},
+		{name: "Reports a command that can't be run", command: []string{"./no-such-command"}, want: []string{u + "#start", u + "#fail"}, wantCode: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pings := mockPings()
+			err := Wrap(context.Background(), "", getPingFlags("--url", u), tt.command)
+
+			var exited *exec.ExitError
+			switch {
+			case tt.wantCode == 0 && err != nil:
+				t.Fatalf("Wrap() error = %v", err)
+			case tt.wantCode > 0 && (!errors.As(err, &exited) || exited.ExitCode() != tt.wantCode):
+				t.Fatalf("Wrap() error = %v, want exit status %d", err, tt.wantCode)
+			case tt.wantCode < 0 && err == nil:
+				t.Fatalf("Wrap() error = nil, want one")
+			}
+			if !reflect.DeepEqual(*pings, tt.want) {
+				t.Errorf("Wrap() sent %v, want %v", *pings, tt.want)
+			}
+		})
+	}
+}
+
+func TestWrap_unreachable(t *testing.T) {
+	apiAuthenticate = func(ctx context.Context) error { return errors.New("credentials rejected") }
+	t.Cleanup(func() { apiAuthenticate = func(ctx context.Context) error { return nil } })
+	pings := mockPings()
+
+	// The command still runs, unreported, and its exit code is kept
+	err := Wrap(context.Background(), "Nightly Backup", getPingFlags(), []string{"sh", "-c", "exit 4"})
+	var exited *exec.ExitError
+	if !errors.As(err, &exited) || exited.ExitCode() != 4 {
+		t.Fatalf("Wrap() error = %v, want exit status 4", err)
+	}
+	if len(*pings) != 0 {
+		t.Errorf("Wrap() sent %v, want nothing", *pings)
+	}
+
+	// But a heartbeat must still be given
+	if err := Wrap(context.Background(), "", getPingFlags(), []string{"true"}); err == nil {
+		t.Errorf("Wrap() error = nil, want one without a heartbeat or --url")
+	}
+}
@@ -0,0 +1,15 @@
+package heartbeat
+
+import (
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/output"
+)
+
+// Table defines the default columns displayed for heartbeat monitors
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("monitor_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "PING NAME", Value: output.Field("name_in_ping_url")},
+	{Header: "STATE", Value: output.Lookup("state", monitor.States)},
+	{Header: "PING URL", Value: output.Field("ping_url")},
+}
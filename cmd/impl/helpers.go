@@ -44,7 +44,15 @@ func SetProperty(v any, property string, value any) bool {
 		return false
 	}
 
-	f.Set(reflect.ValueOf(value))
+	// An optional property, e.g. a *bool, is set to point at the value
+	val := reflect.ValueOf(value)
+	if f.Kind() == reflect.Ptr && val.IsValid() && val.Type() == f.Type().Elem() {
+		p := reflect.New(val.Type())
+		p.Elem().Set(val)
+		val = p
+	}
+
+	f.Set(val)
 	return true
 }
 
@@ -16,10 +16,13 @@ func TestSetProperty(t *testing.T) {
 	type mockStruct struct {
 		MockString string
 		MockInt    int
+		MockBool   *bool
 		private    string
 	}
-	original := mockStruct{"Studio", 54, "Benjamin"}
-	expected := mockStruct{"Area", 54, "Benjamin"}
+	original := mockStruct{"Studio", 54, nil, "Benjamin"}
+	expected := mockStruct{"Area", 54, nil, "Benjamin"}
+	disabled := false
+	optional := mockStruct{"Area", 54, &disabled, "Benjamin"}
 	tests := []struct {
 		name     string
 		args     args
@@ -66,6 +69,16 @@ func TestSetProperty(t *testing.T) {
 			want:     true,
 			expected: expected,
 		},
+		{
+			name: "Points an optional property at the value",
+			args: args{
+				v:        &original,
+				property: "MockBool",
+				value:    false,
+			},
+			want:     true,
+			expected: optional,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
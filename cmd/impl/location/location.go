@@ -0,0 +1,72 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+)
+
+// Alias upstream functions for mocking
+
+var apiLocationList = api.LocationList
+
+// list returns a slice containing all available polling locations
+var list = func(ctx context.Context) ([]api.Location, error) {
+	data, err := apiLocationList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locations []api.Location
+	if err = json.Unmarshal(data, &locations); err != nil {
+		return nil, fmt.Errorf("[location.list] Unable to  parse response data (%s)", err)
+	}
+
+	return locations, nil
+}
+
+// List is the implementation of the `location list` command
+func List(ctx context.Context) ([]byte, error) {
+	locations, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(locations, "", "    ")
+
+	return j, nil
+}
+
+// resolver translates polling location names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a location's display name or,
+// failing that, its city, which is friendlier but not always unique
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "location",
+		Command: "location list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			locations, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(locations))
+			for i, l := range locations {
+				refs[i] = impl.Reference{ID: l.ID, Names: []string{l.Name, l.City}}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// ResolveAll translates polling location names (or IDs) into location IDs.
+// Names are matched without regard to case and may be either the location's
+// display name or its city, e.g. "London - UK" or "london".
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
@@ -0,0 +1,74 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolveAll(t *testing.T) {
+	mockAPIResponse := []byte(`[
+		{"location_id": "1", "display_name": "London - UK", "city": "London"},
+		{"location_id": "2", "display_name": "London - CA", "city": "London"},
+		{"location_id": "3", "display_name": "Paris", "city": "Paris"}
+	]`)
+
+	tests := []struct {
+		name       string
+		refs       []string
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
+		want       []string
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			refs: []string{"Paris"},
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return nil, errors.New("testing")
+			},
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name:      "Resolves names, cities and IDs",
+			refs:      []string{"london - uk", "paris", "2"},
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) { return mockAPIResponse, nil },
+			want:      []string{"1", "3", "2"},
+		},
+		{
+			name:       "Rejects an ambiguous city",
+			refs:       []string{"London"},
+			apiListFn:  func(ctx context.Context) (json.RawMessage, error) { return mockAPIResponse, nil },
+			wantErr:    true,
+			wantErrMsg: "ambiguous location (London) matches IDs 1, 2",
+		},
+		{
+			name:       "Rejects an unknown location",
+			refs:       []string{"Atlantis"},
+			apiListFn:  func(ctx context.Context) (json.RawMessage, error) { return mockAPIResponse, nil },
+			wantErr:    true,
+			wantErrMsg: "unknown location (Atlantis)",
+		},
+	}
+	for _, tt := range tests {
+		apiLocationList = tt.apiListFn
+		resolver = newResolver()
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveAll(context.Background(), tt.refs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("ResolveAll() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package location
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for polling locations
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("location_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "CITY", Value: output.Field("city")},
+	{Header: "COUNTRY", Value: output.Field("country")},
+	{Header: "CONTINENT", Value: output.Field("continent")},
+}
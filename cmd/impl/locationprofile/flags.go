@@ -0,0 +1,51 @@
+package locationprofile
+
+import (
+	"fmt"
+	"site24x7/api"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.StringP("primary-location", "p", "", "Name or ID of the location from which monitors are primarily polled; see `location list`")
+	writerFlags.StringSliceP("secondary-locations", "s", []string{}, "Names or IDs of the locations used to confirm downtime")
+	writerFlags.Bool("restrict-alternate-locations", false, "Never poll from locations outside of the profile")
+
+	return writerFlags
+}
+
+// normalizeName maps a flag name to a property name
+func normalizeName(f *pflag.Flag) string {
+	switch f.Name {
+	// Handle known exceptions to the rule
+	case "restrict-alternate-locations":
+		return "RestrictAlternateLocationPolling"
+
+	// Everything else aligns pretty well with a "-" to CamelCase inflection
+	default:
+		t := cases.Title(language.English).String(f.Name)
+		return strings.Replace(t, "-", "", -1)
+	}
+}
+
+// validate checks the consistency of a fully hydrated profile
+func validate(lp *api.LocationProfile) error {
+	if lp.PrimaryLocation == "" {
+		return fmt.Errorf("a primary location is required")
+	}
+	for _, l := range lp.SecondaryLocations {
+		if l == lp.PrimaryLocation {
+			return fmt.Errorf("the primary location (%s) can't also be a secondary location", l)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package locationprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/location"
+	"site24x7/logger"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiLocationProfileList = api.LocationProfileList
+var apiLocationProfileGet = api.LocationProfileGet
+var apiLocationProfileCreate = api.LocationProfileCreate
+var apiLocationProfileUpdate = api.LocationProfileUpdate
+var apiLocationProfileDelete = api.LocationProfileDelete
+var resolveLocations = location.ResolveAll
+
+// list returns a slice containing all location profiles on the account
+var list = func(ctx context.Context) ([]api.LocationProfile, error) {
+	data, err := apiLocationProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []api.LocationProfile
+	if err = json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("[locationprofile.list] Unable to  parse response data (%s)", err)
+	}
+
+	return profiles, nil
+}
+
+// get fetches a location profile
+var get = func(ctx context.Context, id string) (*api.LocationProfile, error) {
+	var lp api.LocationProfile
+
+	data, err := apiLocationProfileGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &lp); err != nil {
+		return nil, fmt.Errorf("[locationprofile.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &lp, nil
+}
+
+// resolve replaces the location names in a profile with their IDs
+func resolve(ctx context.Context, lp *api.LocationProfile) error {
+	refs := lp.SecondaryLocations
+	if lp.PrimaryLocation != "" {
+		refs = append([]string{lp.PrimaryLocation}, refs...)
+	}
+
+	ids, err := resolveLocations(ctx, refs)
+	if err != nil {
+		return err
+	}
+
+	if lp.PrimaryLocation != "" {
+		lp.PrimaryLocation, ids = ids[0], ids[1:]
+	}
+	lp.SecondaryLocations = ids
+
+	return nil
+}
+
+// Create is the implementation of the `location_profile create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	lp := &api.LocationProfile{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		impl.SetProperty(lp, normalizeName(f), impl.TypedFlagValue(fs, f))
+	})
+
+	if err := resolve(ctx, lp); err != nil {
+		return nil, err
+	}
+	if err := validate(lp); err != nil {
+		return nil, err
+	}
+
+	data, err := apiLocationProfileCreate(ctx, lp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var profile api.LocationProfile
+	if err = json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("[locationprofile.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(profile, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `location_profile get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	lp, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(lp, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `location_profile update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[locationprofile.Update] Updating profile with ID %s", id))
+
+	lp, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[locationprofile.Update] Fetched profile %+v", lp))
+
+	// Hydrate the profile, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		impl.SetProperty(lp, normalizeName(f), impl.TypedFlagValue(fs, f))
+	})
+
+	if err := resolve(ctx, lp); err != nil {
+		return nil, err
+	}
+	if err := validate(lp); err != nil {
+		return nil, err
+	}
+
+	data, err := apiLocationProfileUpdate(ctx, lp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var lpOut api.LocationProfile
+	if err = json.Unmarshal(data, &lpOut); err != nil {
+		return nil, fmt.Errorf("[locationprofile.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(lpOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `location_profile delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiLocationProfileDelete(ctx, id)
+}
+
+// List is the implementation of the `location_profile list` command
+func List(ctx context.Context) ([]byte, error) {
+	profiles, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(profiles, "", "    ")
+
+	return j, nil
+}
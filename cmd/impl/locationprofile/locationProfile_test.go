@@ -0,0 +1,147 @@
+package locationprofile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+// mockResolve maps location names (or IDs) to IDs
+func mockResolve(ctx context.Context, refs []string) ([]string, error) {
+	ids := map[string]string{"London": "1", "Paris": "2", "Frankfurt": "3", "1": "1", "2": "2", "3": "3"}
+
+	var out []string
+	for _, r := range refs {
+		id, ok := ids[r]
+		if !ok {
+			return nil, errors.New("unknown location")
+		}
+		out = append(out, id)
+	}
+
+	return out, nil
+}
+
+func TestCreate(t *testing.T) {
+	resolveLocations = mockResolve
+
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		apiCreateFn func(ctx context.Context, lp *api.LocationProfile) (json.RawMessage, error)
+		want        *api.LocationProfile
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name:       "Requires a primary location",
+			flags:      map[string]string{},
+			wantErr:    true,
+			wantErrMsg: "a primary location is required",
+		},
+		{
+			name:       "Rejects an unknown location",
+			flags:      map[string]string{"primary-location": "Atlantis"},
+			wantErr:    true,
+			wantErrMsg: "unknown location",
+		},
+		{
+			name:       "Rejects a primary location that's also secondary",
+			flags:      map[string]string{"primary-location": "London", "secondary-locations": "Paris,London"},
+			wantErr:    true,
+			wantErrMsg: "can't also be a secondary location",
+		},
+		{
+			name:  "Creates a profile with locations by name",
+			flags: map[string]string{"primary-location": "London", "secondary-locations": "Paris,Frankfurt"},
+			apiCreateFn: func(ctx context.Context, lp *api.LocationProfile) (json.RawMessage, error) {
+				// return what was sent
+				j, _ := json.Marshal(lp)
+
+				return j, nil
+			},
+			want: &api.LocationProfile{
+				Name:               "Test Profile",
+				PrimaryLocation:    "1",
+				SecondaryLocations: []string{"2", "3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		apiLocationProfileCreate = tt.apiCreateFn
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			for k, v := range tt.flags {
+				fs.Set(k, v)
+			}
+
+			got, err := Create(context.Background(), "Test Profile", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Create() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			want, _ := json.MarshalIndent(tt.want, "", "    ")
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Create() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	resolveLocations = mockResolve
+
+	fs := GetWriterFlags()
+	fs.Set("secondary-locations", "Frankfurt")
+
+	get = func(ctx context.Context, id string) (*api.LocationProfile, error) {
+		return &api.LocationProfile{ID: id, Name: "Test", PrimaryLocation: "1", SecondaryLocations: []string{"2"}}, nil
+	}
+	apiLocationProfileUpdate = func(ctx context.Context, lp *api.LocationProfile) (json.RawMessage, error) {
+		j, _ := json.Marshal(lp)
+
+		return j, nil
+	}
+
+	want, _ := json.MarshalIndent(&api.LocationProfile{
+		ID:                 "1001001SOS",
+		Name:               "Test",
+		PrimaryLocation:    "1",
+		SecondaryLocations: []string{"3"},
+	}, "", "    ")
+
+	got, err := Update(context.Background(), "1001001SOS", fs)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Update() = %v, want %v", string(got), string(want))
+	}
+}
+
+func TestDelete(t *testing.T) {
+	apiLocationProfileDelete = func(ctx context.Context, id string) error {
+		return errors.New("testing")
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err == nil {
+		t.Errorf("Delete() expected an error")
+	}
+
+	apiLocationProfileDelete = func(ctx context.Context, id string) error {
+		return nil
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err != nil {
+		t.Errorf("Delete() expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,12 @@
+package locationprofile
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for location profiles
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("profile_id")},
+	{Header: "NAME", Value: output.Field("profile_name")},
+	{Header: "PRIMARY LOCATION", Value: output.Field("primary_location")},
+	{Header: "SECONDARY LOCATIONS", Value: output.Field("secondary_locations")},
+	{Header: "RESTRICTED", Value: output.Field("restrict_alternate_location_polling")},
+}
@@ -0,0 +1,124 @@
+package maintenance
+
+import (
+	"fmt"
+	"site24x7/api"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.StringP("type", "t", "once", "How often the window recurs: once, daily, weekly or monthly")
+	writerFlags.StringP("description", "d", "", "Description of the maintenance, e.g. the reason for it")
+	writerFlags.String("start", "", "When the (first) window starts, in RFC3339 (2021-06-01T22:00:00Z) or local time (2021-06-01 22:00)")
+	writerFlags.String("end", "", "When the (first) window ends, in RFC3339 or local time; only the time of day is used for recurring windows")
+	writerFlags.String("until", "", "Date (2021-12-31) of the last occurrence of a recurring window")
+	writerFlags.String("timezone", "", "Time zone, e.g. Europe/London, in which local times are given and the window is scheduled (default: local times in the system time zone, scheduled in UTC)")
+	writerFlags.StringSlice("days", []string{}, "Days of the week on which a weekly window occurs, e.g. mon,wed,fri")
+	writerFlags.StringSliceP("monitors", "m", []string{}, "Identifiers of the monitors to which the window applies")
+	writerFlags.StringSliceP("monitor-groups", "g", []string{}, "IDs or names of the monitor groups to which the window applies")
+	writerFlags.StringSlice("tags", []string{}, "Identifiers of the tags whose monitors the window applies to")
+	writerFlags.Bool("perform-monitoring", false, "Keep monitoring during the window; only alerts are suppressed")
+
+	return writerFlags
+}
+
+// validateWriters validates writable values passed to the command via flags.
+// Only flags that were changed are validated; we should be able to safely
+// assume that default values are valid.
+func validateWriters(fs *pflag.FlagSet) error {
+	var err error
+	var targets []string
+
+	fs.Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "type":
+			v, _ := fs.GetString(f.Name)
+			if _, ok := typeNames[strings.ToLower(v)]; !ok {
+				err = fmt.Errorf("invalid maintenance type (%s); expected once, daily, weekly or monthly", v)
+			}
+		case "days":
+			v, _ := fs.GetStringSlice(f.Name)
+			for _, d := range v {
+				if _, ok := WeekDays[dayName(d)]; !ok {
+					err = fmt.Errorf("invalid day (%s); expected one of sun, mon, tue, wed, thu, fri or sat", d)
+				}
+			}
+		case "monitors", "monitor-groups", "tags":
+			targets = append(targets, "--"+f.Name)
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(targets) > 1 {
+		return fmt.Errorf("a window can target only one kind of resource; received %s", strings.Join(targets, " and "))
+	}
+
+	return nil
+}
+
+// validate checks the consistency of a fully hydrated window
+func validate(mw *api.MaintenanceWindow) error {
+	if _, ok := Types[mw.Type]; !ok {
+		return fmt.Errorf("invalid maintenance type (%d)", mw.Type)
+	}
+
+	switch mw.SelectionType {
+	case 0:
+		// All monitors; nothing to check
+	case 1:
+		if len(mw.MonitorGroups) == 0 {
+			return fmt.Errorf("no monitor groups given")
+		}
+	case 2:
+		if len(mw.Monitors) == 0 {
+			return fmt.Errorf("no monitors given")
+		}
+	case 3:
+		if len(mw.Tags) == 0 {
+			return fmt.Errorf("no tags given")
+		}
+	default:
+		return fmt.Errorf("invalid selection type (%d)", mw.SelectionType)
+	}
+
+	if mw.StartDate == "" || mw.StartTime == "" || mw.EndTime == "" {
+		return fmt.Errorf("a start and end time are required")
+	}
+
+	// Dates and times are zero padded, so compare perfectly well as strings
+	if mw.Type == 1 {
+		if mw.EndDate+mw.EndTime <= mw.StartDate+mw.StartTime {
+			return fmt.Errorf("the window must end after it starts")
+		}
+	} else {
+		if mw.EndDate == "" || mw.EndDate < mw.StartDate {
+			return fmt.Errorf("a recurring window requires an --until date on or after its start")
+		}
+		if mw.EndTime == mw.StartTime {
+			return fmt.Errorf("the window must end after it starts")
+		}
+	}
+	if mw.Type == 3 && len(mw.Days) == 0 {
+		return fmt.Errorf("a weekly window requires --days")
+	}
+
+	return nil
+}
+
+// dayName normalizes a day of the week, e.g. Monday to mon
+func dayName(d string) string {
+	d = strings.ToLower(strings.TrimSpace(d))
+	if len(d) > 3 {
+		d = d[:3]
+	}
+
+	return d
+}
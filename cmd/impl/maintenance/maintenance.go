@@ -0,0 +1,211 @@
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/logger"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiMaintenanceWindowList = api.MaintenanceWindowList
+var apiMaintenanceWindowGet = api.MaintenanceWindowGet
+var apiMaintenanceWindowCreate = api.MaintenanceWindowCreate
+var apiMaintenanceWindowUpdate = api.MaintenanceWindowUpdate
+var apiMaintenanceWindowDelete = api.MaintenanceWindowDelete
+var resolveMonitorGroups = monitorgroup.ResolveAll
+
+// list returns a slice containing all maintenance windows on the account
+var list = func(ctx context.Context) ([]api.MaintenanceWindow, error) {
+	data, err := apiMaintenanceWindowList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var windows []api.MaintenanceWindow
+	if err = json.Unmarshal(data, &windows); err != nil {
+		return nil, fmt.Errorf("[maintenance.list] Unable to  parse response data (%s)", err)
+	}
+
+	return windows, nil
+}
+
+// get fetches a maintenance window
+var get = func(ctx context.Context, id string) (*api.MaintenanceWindow, error) {
+	var mw api.MaintenanceWindow
+
+	data, err := apiMaintenanceWindowGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &mw); err != nil {
+		return nil, fmt.Errorf("[maintenance.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &mw, nil
+}
+
+// hydrate sets a window property from a flag; times are handled separately by
+// schedule()
+func hydrate(mw *api.MaintenanceWindow, fs *pflag.FlagSet, f *pflag.Flag) {
+	switch f.Name {
+	case "type":
+		v, _ := fs.GetString(f.Name)
+		mw.Type = typeNames[strings.ToLower(v)]
+	case "description":
+		mw.Description, _ = fs.GetString(f.Name)
+	case "days":
+		v, _ := fs.GetStringSlice(f.Name)
+		mw.Days = nil
+		for _, d := range v {
+			mw.Days = append(mw.Days, WeekDays[dayName(d)])
+		}
+	case "perform-monitoring":
+		mw.PerformMonitoring, _ = fs.GetBool(f.Name)
+	case "monitors", "monitor-groups", "tags":
+		v, _ := fs.GetStringSlice(f.Name)
+		if len(v) == 0 {
+			return
+		}
+
+		// A window targets a single kind of resource
+		mw.Monitors, mw.MonitorGroups, mw.Tags = nil, nil, nil
+		switch f.Name {
+		case "monitor-groups":
+			mw.SelectionType = 1
+			mw.MonitorGroups = v
+		case "monitors":
+			mw.SelectionType = 2
+			mw.Monitors = v
+		case "tags":
+			mw.SelectionType = 3
+			mw.Tags = v
+		}
+	}
+}
+
+// Create is the implementation of the `maintenance create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	mw := &api.MaintenanceWindow{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		hydrate(mw, fs, f)
+	})
+	// Never default to suppressing alerts for every monitor on the account
+	if len(mw.Monitors)+len(mw.MonitorGroups)+len(mw.Tags) == 0 {
+		return nil, fmt.Errorf("a target is required; use --monitors, --monitor-groups or --tags")
+	}
+	if err := schedule(mw, fs, func(string) bool { return true }); err != nil {
+		return nil, err
+	}
+	var err error
+	if mw.MonitorGroups, err = resolveMonitorGroups(ctx, mw.MonitorGroups); err != nil {
+		return nil, err
+	}
+
+	if err := validate(mw); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMaintenanceWindowCreate(ctx, mw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated window struct
+	var window api.MaintenanceWindow
+	if err = json.Unmarshal(data, &window); err != nil {
+		return nil, fmt.Errorf("[maintenance.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(window, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `maintenance get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	mw, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(mw, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `maintenance update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[maintenance.Update] Updating window with ID %s", id))
+
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	mw, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[maintenance.Update] Fetched window %+v", mw))
+
+	// Hydrate the window, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		hydrate(mw, fs, f)
+	})
+	if err := schedule(mw, fs, fs.Changed); err != nil {
+		return nil, err
+	}
+	if mw.MonitorGroups, err = resolveMonitorGroups(ctx, mw.MonitorGroups); err != nil {
+		return nil, err
+	}
+
+	if err := validate(mw); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMaintenanceWindowUpdate(ctx, mw)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated window struct
+	var mwOut api.MaintenanceWindow
+	if err = json.Unmarshal(data, &mwOut); err != nil {
+		return nil, fmt.Errorf("[maintenance.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(mwOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `maintenance delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiMaintenanceWindowDelete(ctx, id)
+}
+
+// List is the implementation of the `maintenance list` command
+func List(ctx context.Context) ([]byte, error) {
+	windows, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(windows, "", "    ")
+
+	return j, nil
+}
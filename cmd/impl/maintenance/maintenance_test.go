@@ -0,0 +1,192 @@
+package maintenance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+func TestCreate(t *testing.T) {
+	// return what was sent
+	echo := func(ctx context.Context, mw *api.MaintenanceWindow) (json.RawMessage, error) {
+		j, _ := json.Marshal(mw)
+
+		return j, nil
+	}
+
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		apiCreateFn func(ctx context.Context, mw *api.MaintenanceWindow) (json.RawMessage, error)
+		want        *api.MaintenanceWindow
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name:       "Requires a target",
+			flags:      map[string]string{"start": "2021-06-01T22:00:00Z", "end": "2021-06-01T23:00:00Z"},
+			wantErr:    true,
+			wantErrMsg: "a target is required",
+		},
+		{
+			name:       "Rejects more than one kind of target",
+			flags:      map[string]string{"monitors": "1", "tags": "2"},
+			wantErr:    true,
+			wantErrMsg: "only one kind of resource",
+		},
+		{
+			name:       "Rejects an invalid time",
+			flags:      map[string]string{"monitors": "1", "start": "tomorrow", "end": "2021-06-01T23:00:00Z"},
+			wantErr:    true,
+			wantErrMsg: "invalid time (tomorrow)",
+		},
+		{
+			name:       "Rejects a window that ends before it starts",
+			flags:      map[string]string{"monitors": "1", "start": "2021-06-01T22:00:00Z", "end": "2021-06-01T21:00:00Z"},
+			wantErr:    true,
+			wantErrMsg: "must end after it starts",
+		},
+		{
+			name:       "Requires an end date for recurring windows",
+			flags:      map[string]string{"type": "daily", "monitors": "1", "start": "2021-06-01T22:00:00Z", "end": "2021-06-01T23:00:00Z"},
+			wantErr:    true,
+			wantErrMsg: "requires an --until date",
+		},
+		{
+			name:        "Creates a one-time window in UTC",
+			flags:       map[string]string{"monitor-groups": "123", "start": "2021-06-01T22:00:00+01:00", "end": "2021-06-02T00:30:00+01:00"},
+			apiCreateFn: echo,
+			want: &api.MaintenanceWindow{
+				Name:          "Test Window",
+				Type:          1,
+				StartDate:     "2021-06-01",
+				StartTime:     "21:00",
+				EndDate:       "2021-06-01",
+				EndTime:       "23:30",
+				TimeZone:      "UTC",
+				SelectionType: 1,
+				MonitorGroups: []string{"123"},
+			},
+		},
+		{
+			name: "Creates a weekly window in local time",
+			flags: map[string]string{
+				"type":     "weekly",
+				"days":     "Saturday,sun",
+				"tags":     "456",
+				"start":    "2021-06-05 02:00",
+				"end":      "2021-06-05 03:00",
+				"until":    "2021-12-31",
+				"timezone": "Europe/London",
+			},
+			apiCreateFn: echo,
+			want: &api.MaintenanceWindow{
+				Name:          "Test Window",
+				Type:          3,
+				StartDate:     "2021-06-05",
+				StartTime:     "02:00",
+				EndDate:       "2021-12-31",
+				EndTime:       "03:00",
+				TimeZone:      "Europe/London",
+				Days:          []int{7, 1},
+				SelectionType: 3,
+				Tags:          []string{"456"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		apiMaintenanceWindowCreate = tt.apiCreateFn
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			for k, v := range tt.flags {
+				fs.Set(k, v)
+			}
+
+			got, err := Create(context.Background(), "Test Window", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Create() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			want, _ := json.MarshalIndent(tt.want, "", "    ")
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Create() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fs := GetWriterFlags()
+	fs.Set("monitors", "789")
+	fs.Set("end", "2021-06-01T23:00:00Z")
+
+	get = func(ctx context.Context, id string) (*api.MaintenanceWindow, error) {
+		return &api.MaintenanceWindow{
+			ID:            id,
+			Name:          "Test",
+			Type:          1,
+			StartDate:     "2021-06-01",
+			StartTime:     "23:00",
+			EndDate:       "2021-06-02",
+			EndTime:       "00:00",
+			TimeZone:      "Europe/London",
+			SelectionType: 1,
+			MonitorGroups: []string{"123"},
+		}, nil
+	}
+	apiMaintenanceWindowUpdate = func(ctx context.Context, mw *api.MaintenanceWindow) (json.RawMessage, error) {
+		j, _ := json.Marshal(mw)
+
+		return j, nil
+	}
+
+	// The new end time is converted to the window's time zone, and the new
+	// target replaces the old
+	want, _ := json.MarshalIndent(&api.MaintenanceWindow{
+		ID:            "1001001SOS",
+		Name:          "Test",
+		Type:          1,
+		StartDate:     "2021-06-01",
+		StartTime:     "23:00",
+		EndDate:       "2021-06-02",
+		EndTime:       "00:00",
+		TimeZone:      "Europe/London",
+		SelectionType: 2,
+		Monitors:      []string{"789"},
+	}, "", "    ")
+
+	got, err := Update(context.Background(), "1001001SOS", fs)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Update() = %v, want %v", string(got), string(want))
+	}
+}
+
+func TestDelete(t *testing.T) {
+	apiMaintenanceWindowDelete = func(ctx context.Context, id string) error {
+		return errors.New("testing")
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err == nil {
+		t.Errorf("Delete() expected an error")
+	}
+
+	apiMaintenanceWindowDelete = func(ctx context.Context, id string) error {
+		return nil
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err != nil {
+		t.Errorf("Delete() expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,99 @@
+package maintenance
+
+import (
+	"fmt"
+	"site24x7/api"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// localLayouts are the layouts accepted for times without a UTC offset, which
+// are read in the --timezone (or system) time zone
+var localLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// The layouts of dates and times that Site24x7 expects
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04"
+)
+
+// parseTime reads a time in RFC3339 or one of the local layouts
+func parseTime(v string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	for _, layout := range localLayouts {
+		if t, err := time.ParseInLocation(layout, v, loc); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time (%s); expected RFC3339, e.g. 2021-06-01T22:00:00Z, or local time, e.g. 2021-06-01 22:00", v)
+}
+
+// schedule sets the dates and times of a window from the --start, --end and
+// --until flags. Times are read in the --timezone time zone, or the system's
+// when it isn't given, and converted to the time zone that the window is
+// scheduled in: --timezone, the window's existing time zone, or else UTC.
+// Only flags for which visit returns true are applied.
+func schedule(mw *api.MaintenanceWindow, fs *pflag.FlagSet, visit func(name string) bool) error {
+	in := time.Local
+	if tz, _ := fs.GetString("timezone"); tz != "" && visit("timezone") {
+		loc, err := time.LoadLocation(tz)
+		if err != nil {
+			return fmt.Errorf("invalid time zone (%s)", tz)
+		}
+		in = loc
+		mw.TimeZone = tz
+	}
+	if mw.TimeZone == "" {
+		mw.TimeZone = "UTC"
+	}
+	out, err := time.LoadLocation(mw.TimeZone)
+	if err != nil {
+		return fmt.Errorf("invalid time zone (%s)", mw.TimeZone)
+	}
+
+	for _, name := range []string{"start", "end", "until"} {
+		v, _ := fs.GetString(name)
+		if v == "" || !visit(name) {
+			continue
+		}
+
+		// A date alone is taken as given, rather than as midnight in some
+		// time zone that might fall on another day in the window's
+		t, err := time.Parse(dateLayout, v)
+		if err != nil {
+			if t, err = parseTime(v, in); err != nil {
+				return err
+			}
+			t = t.In(out)
+		}
+
+		switch name {
+		case "start":
+			mw.StartDate = t.Format(dateLayout)
+			mw.StartTime = t.Format(timeLayout)
+		case "end":
+			mw.EndTime = t.Format(timeLayout)
+			// A recurring window ends on its --until date
+			if mw.Type == 1 {
+				mw.EndDate = t.Format(dateLayout)
+			}
+		case "until":
+			if mw.Type == 1 {
+				return fmt.Errorf("--until only applies to recurring windows")
+			}
+			mw.EndDate = t.Format(dateLayout)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,21 @@
+package maintenance
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for maintenance windows
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("maintenance_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "TYPE", Value: output.Lookup("maintenance_type", Types)},
+	{Header: "STARTS", Value: dateTime("start_date", "start_time")},
+	{Header: "ENDS", Value: dateTime("end_date", "end_time")},
+	{Header: "TIME ZONE", Value: output.Field("time_zone")},
+	{Header: "TARGETS", Value: output.Lookup("selection_type", SelectionTypes)},
+}
+
+// dateTime returns a column value function that joins a date and a time
+func dateTime(date string, time string) func(map[string]interface{}) string {
+	return func(row map[string]interface{}) string {
+		return output.Field(date)(row) + " " + output.Field(time)(row)
+	}
+}
@@ -0,0 +1,39 @@
+package maintenance
+
+// Types maps maintenance type ids to friendly names
+// https://www.site24x7.com/help/api/#maintenance_type
+var Types = map[int]string{
+	1: "Once",
+	2: "Daily",
+	3: "Weekly",
+	4: "Monthly",
+}
+
+// typeNames maps the names accepted by the --type flag to maintenance types
+var typeNames = map[string]int{
+	"once":    1,
+	"daily":   2,
+	"weekly":  3,
+	"monthly": 4,
+}
+
+// SelectionTypes maps the kinds of resource a window can target to friendly
+// names
+// https://www.site24x7.com/help/api/#resource_type_constants
+var SelectionTypes = map[int]string{
+	0: "All monitors",
+	1: "Monitor groups",
+	2: "Monitors",
+	3: "Tags",
+}
+
+// WeekDays maps the day names accepted by the --days flag to day ids
+var WeekDays = map[string]int{
+	"sun": 1,
+	"mon": 2,
+	"tue": 3,
+	"wed": 4,
+	"thu": 5,
+	"fri": 6,
+	"sat": 7,
+}
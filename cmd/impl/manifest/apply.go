@@ -0,0 +1,299 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/logger"
+	"sort"
+	"strings"
+)
+
+// The actions that may be planned for, or taken on, an object
+const (
+	ActionCreate    = "create"
+	ActionCreated   = "created"
+	ActionUpdate    = "update"
+	ActionUpdated   = "updated"
+	ActionUnchanged = "unchanged"
+	ActionFailed    = "failed"
+)
+
+// Change describes what applying a manifest does to an object
+type Change struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	ID     string `json:"id,omitempty"`
+	Action string `json:"action"`
+	Error  string `json:"error,omitempty"`
+	Source string `json:"source"`
+
+	kind *kind
+	// live and desired hold the object, as its api type, before and after
+	// the change
+	live    interface{}
+	desired interface{}
+}
+
+// fail marks a change as failed
+func (c *Change) fail(err error) *Change {
+	c.Action = ActionFailed
+	c.Error = err.Error()
+
+	return c
+}
+
+// planner works out the changes needed to apply manifests, fetching each kind
+// of object at most once
+type planner struct {
+	objects map[*kind][]map[string]interface{}
+	seen    map[string]string
+}
+
+// Plan works out the change needed to apply each manifest without writing
+// anything. Manifests are planned by kind, in the order that kinds are
+// applied, then in the order given.
+func Plan(ctx context.Context, manifests []Manifest) []*Change {
+	p := &planner{objects: map[*kind][]map[string]interface{}{}, seen: map[string]string{}}
+
+	sorted := make([]Manifest, len(manifests))
+	copy(sorted, manifests)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return kindOrder(sorted[i].Kind) < kindOrder(sorted[j].Kind)
+	})
+
+	var changes []*Change
+	for _, m := range sorted {
+		changes = append(changes, p.plan(ctx, m))
+	}
+
+	return changes
+}
+
+// kindOrder returns the position in which a kind is applied; unsupported kinds
+// come last
+func kindOrder(name string) int {
+	k, err := lookupKind(name)
+	if err != nil {
+		return len(kinds)
+	}
+	for i := range kinds {
+		if kinds[i] == k {
+			return i
+		}
+	}
+
+	return len(kinds)
+}
+
+// plan works out the change needed to apply a single manifest
+func (p *planner) plan(ctx context.Context, m Manifest) *Change {
+	c := &Change{Kind: m.Kind, Source: m.Source}
+
+	k, err := lookupKind(m.Kind)
+	if err != nil {
+		return c.fail(err)
+	}
+	c.Kind = k.Name
+	c.kind = k
+
+	spec, _ := json.Marshal(m.Spec)
+	if err := decodeStrict(spec, k.New()); err != nil {
+		return c.fail(fmt.Errorf("invalid spec (%s)", err))
+	}
+
+	id, _ := m.Spec[k.IDField].(string)
+	key, _ := m.Spec[k.KeyField].(string)
+	if id == "" && key == "" {
+		return c.fail(fmt.Errorf("spec requires either %s or %s", k.KeyField, k.IDField))
+	}
+	c.Name = key
+
+	// The same object shouldn't be described twice
+	ref := fmt.Sprintf("%s/%s", k.Name, strings.ToLower(key))
+	if id != "" {
+		ref = fmt.Sprintf("%s/%s", k.Name, id)
+	}
+	if source, ok := p.seen[ref]; ok {
+		return c.fail(fmt.Errorf("duplicates the manifest in %s", source))
+	}
+	p.seen[ref] = m.Source
+
+	match, err := p.find(ctx, k, id, key)
+	if err != nil {
+		return c.fail(err)
+	}
+
+	if match == "" {
+		if id != "" {
+			return c.fail(fmt.Errorf("%s %s not found", k.Name, id))
+		}
+
+		desired := k.New()
+		decodeStrict(spec, desired)
+		c.Action = ActionCreate
+		c.desired = desired
+
+		return c
+	}
+	c.ID = match
+
+	// Overlay the manifest onto the full live object so that properties it
+	// doesn't mention are left alone
+	data, err := k.Get(ctx, match)
+	if err != nil {
+		return c.fail(err)
+	}
+	live, desired := k.New(), k.New()
+	if err := json.Unmarshal(data, live); err != nil {
+		return c.fail(fmt.Errorf("[manifest.plan] Unable to  parse response data (%s)", err))
+	}
+	json.Unmarshal(data, desired)
+	decodeStrict(spec, desired)
+
+	c.live = live
+	c.desired = desired
+	if c.Name == "" {
+		var props map[string]interface{}
+		json.Unmarshal(data, &props)
+		c.Name, _ = props[k.KeyField].(string)
+	}
+
+	// Only properties that are written can drift
+	before, _ := api.RequestBody(live)
+	after, _ := api.RequestBody(desired)
+	if string(before) == string(after) {
+		c.Action = ActionUnchanged
+	} else {
+		c.Action = ActionUpdate
+	}
+
+	return c
+}
+
+// find returns the identifier of the live object with a given identifier or
+// key, if there is one
+func (p *planner) find(ctx context.Context, k *kind, id string, key string) (string, error) {
+	objects, ok := p.objects[k]
+	if !ok {
+		data, err := k.List(ctx)
+		if err != nil {
+			return "", err
+		}
+		if err := json.Unmarshal(data, &objects); err != nil {
+			return "", fmt.Errorf("[manifest.find] Unable to  parse response data (%s)", err)
+		}
+		p.objects[k] = objects
+	}
+
+	var matches []string
+	for _, o := range objects {
+		oid, _ := o[k.IDField].(string)
+		okey, _ := o[k.KeyField].(string)
+		if id != "" && oid == id {
+			return oid, nil
+		}
+		if id == "" && strings.EqualFold(okey, key) {
+			matches = append(matches, oid)
+		}
+	}
+
+	if len(matches) > 1 {
+		return "", fmt.Errorf("ambiguous %s (%s) matches IDs %s; add %s to the spec", k.KeyField, key, strings.Join(matches, ", "), k.IDField)
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+
+	return "", nil
+}
+
+// execute makes a planned change
+func (c *Change) execute(ctx context.Context) {
+	var data []byte
+	var err error
+	var done string
+
+	switch c.Action {
+	case ActionCreate:
+		data, err = c.kind.Create(ctx, c.desired)
+		done = ActionCreated
+	case ActionUpdate:
+		data, err = c.kind.Update(ctx, c.desired)
+		done = ActionUpdated
+	default:
+		return
+	}
+	if err != nil {
+		c.fail(err)
+		return
+	}
+
+	var props map[string]interface{}
+	if err := json.Unmarshal(data, &props); err == nil {
+		if id, ok := props[c.kind.IDField].(string); ok {
+			c.ID = id
+		}
+	}
+	c.Action = done
+}
+
+// summarize counts changes by action, e.g. "2 created, 1 unchanged"
+func summarize(changes []*Change) string {
+	counts := map[string]int{}
+	var actions []string
+	for _, c := range changes {
+		if counts[c.Action] == 0 {
+			actions = append(actions, c.Action)
+		}
+		counts[c.Action]++
+	}
+
+	var parts []string
+	for _, a := range actions {
+		parts = append(parts, fmt.Sprintf("%d %s", counts[a], a))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// Apply is the implementation of the `apply` command. It returns the changes
+// made, a summary of them and, if any failed, an error. Once the context is
+// cancelled, the changes yet to be made are reported as planned.
+func Apply(ctx context.Context, path string) ([]byte, string, error) {
+	manifests, err := Load(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(manifests) == 0 {
+		return nil, "", fmt.Errorf("no manifests found in %s", path)
+	}
+
+	changes := Plan(ctx, manifests)
+
+	var failed int
+	for _, c := range changes {
+		// A dry run reports the plan; there's nothing to be gained from
+		// simulating each request
+		if !api.DryRun() && ctx.Err() == nil {
+			logger.Info(fmt.Sprintf("[manifest.Apply] %s %s/%s", c.Action, c.Kind, c.Name))
+			c.execute(ctx)
+		}
+		if c.Action == ActionFailed {
+			failed++
+		}
+	}
+
+	j, _ := json.MarshalIndent(changes, "", "    ")
+	summary := summarize(changes)
+
+	if err := ctx.Err(); err != nil {
+		return j, summary, err
+	}
+	if failed > 0 {
+		return j, summary, fmt.Errorf("%d of %d manifests failed to apply", failed, len(changes))
+	}
+
+	return j, summary, nil
+}
@@ -0,0 +1,92 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"testing"
+)
+
+func TestPlan(t *testing.T) {
+	apiUserGroupList = func(ctx context.Context) (json.RawMessage, error) {
+		return []byte(`[
+			{"user_group_id": "1", "display_name": "Ops", "users": ["100"]},
+			{"user_group_id": "2", "display_name": "Dev", "users": ["200"]},
+			{"user_group_id": "3", "display_name": "Dev", "users": ["300"]}
+		]`), nil
+	}
+	apiUserGroupGet = func(ctx context.Context, id string) (json.RawMessage, error) {
+		return []byte(`{"user_group_id": "1", "display_name": "Ops", "product_id": 0, "users": ["100"], "attribute_group_id": "9"}`), nil
+	}
+	apiMonitorGroupList = func(ctx context.Context, withSubgroups bool) (json.RawMessage, error) {
+		return nil, errors.New("testing")
+	}
+
+	manifests := []Manifest{
+		{Kind: "monitor_group", Spec: map[string]interface{}{"display_name": "Web"}, Source: "a"},
+		{Kind: "UserGroup", Spec: map[string]interface{}{"display_name": "Ops"}, Source: "b"},
+		{Kind: "UserGroup", Spec: map[string]interface{}{"user_group_id": "1", "users": []interface{}{"100", "101"}}, Source: "c"},
+		{Kind: "UserGroup", Spec: map[string]interface{}{"display_name": "QA"}, Source: "d"},
+		{Kind: "UserGroup", Spec: map[string]interface{}{"display_name": "Dev"}, Source: "e"},
+		{Kind: "UserGroup", Spec: map[string]interface{}{"display_name": "Typo", "userz": []interface{}{}}, Source: "f"},
+		{Kind: "Widget", Spec: map[string]interface{}{"display_name": "?"}, Source: "g"},
+	}
+
+	got := Plan(context.Background(), manifests)
+
+	want := []struct {
+		source string
+		action string
+		err    string
+	}{
+		{"b", ActionUnchanged, ""},
+		{"c", ActionUpdate, ""},
+		{"d", ActionCreate, ""},
+		{"e", ActionFailed, "ambiguous display_name (Dev) matches IDs 2, 3; add user_group_id to the spec"},
+		{"f", ActionFailed, `invalid spec (json: unknown field "userz")`},
+		{"a", ActionFailed, "testing"},
+		{"g", ActionFailed, "unsupported kind (Widget); expected one of LocationProfile, NotificationProfile, ThresholdProfile, User, UserGroup, MonitorGroup, Monitor, MaintenanceWindow"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Plan() returned %d changes, want %d", len(got), len(want))
+	}
+	for i, w := range want {
+		if got[i].Source != w.source || got[i].Action != w.action || got[i].Error != w.err {
+			t.Errorf("Plan()[%d] = %s %s %q, want %s %s %q", i, got[i].Source, got[i].Action, got[i].Error, w.source, w.action, w.err)
+		}
+	}
+
+	// Properties that aren't in the manifest are left alone
+	wantDesired := &api.UserGroup{ID: "1", Name: "Ops", Users: []string{"100", "101"}, AttributeGroup: "9"}
+	if !reflect.DeepEqual(got[1].desired, wantDesired) {
+		t.Errorf("Plan() desired = %+v, want %+v", got[1].desired, wantDesired)
+	}
+}
+
+func TestChange_execute(t *testing.T) {
+	apiUserGroupCreate = func(ctx context.Context, ug *api.UserGroup) (json.RawMessage, error) {
+		return []byte(`{"user_group_id": "4", "display_name": "QA"}`), nil
+	}
+	apiUserGroupUpdate = func(ctx context.Context, ug *api.UserGroup) (json.RawMessage, error) {
+		return nil, errors.New("testing")
+	}
+	k, _ := lookupKind("UserGroup")
+
+	created := &Change{Action: ActionCreate, kind: k, desired: &api.UserGroup{Name: "QA"}}
+	created.execute(context.Background())
+	if created.Action != ActionCreated || created.ID != "4" {
+		t.Errorf("execute() = %s %s, want %s 4", created.Action, created.ID, ActionCreated)
+	}
+
+	failed := &Change{Action: ActionUpdate, kind: k, desired: &api.UserGroup{ID: "1"}}
+	failed.execute(context.Background())
+	if failed.Action != ActionFailed || failed.Error != "testing" {
+		t.Errorf("execute() = %s %q, want %s \"testing\"", failed.Action, failed.Error, ActionFailed)
+	}
+
+	if s := summarize([]*Change{created, failed, {Action: ActionUnchanged}, {Action: ActionUnchanged}}); s != "1 created, 1 failed, 2 unchanged" {
+		t.Errorf("summarize() = %s", s)
+	}
+}
@@ -0,0 +1,187 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"sort"
+	"strings"
+)
+
+// ANSI escape sequences used to colorize a diff
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// FieldDiff describes a single property that differs between the live object
+// and its manifest
+type FieldDiff struct {
+	Field   string      `json:"field"`
+	Live    interface{} `json:"live"`
+	Desired interface{} `json:"desired"`
+}
+
+// diff compares what's written for the live object with what would be written
+// for the desired one, property by property. Nested properties are compared
+// individually and named by their dotted path, e.g. alert_settings.up; lists
+// are compared as a whole.
+func (c *Change) diff() []FieldDiff {
+	if c.Action != ActionCreate && c.Action != ActionUpdate {
+		return nil
+	}
+
+	live := map[string]interface{}{}
+	if c.live != nil {
+		b, _ := api.RequestBody(c.live)
+		var v interface{}
+		json.Unmarshal(b, &v)
+		flatten("", v, live)
+	}
+
+	desired := map[string]interface{}{}
+	b, _ := api.RequestBody(c.desired)
+	var v interface{}
+	json.Unmarshal(b, &v)
+	flatten("", v, desired)
+
+	fields := map[string]bool{}
+	for f := range live {
+		fields[f] = true
+	}
+	for f := range desired {
+		fields[f] = true
+	}
+
+	var names []string
+	for f := range fields {
+		names = append(names, f)
+	}
+	sort.Strings(names)
+
+	var diffs []FieldDiff
+	for _, f := range names {
+		l, _ := json.Marshal(live[f])
+		d, _ := json.Marshal(desired[f])
+		if string(l) != string(d) {
+			diffs = append(diffs, FieldDiff{Field: f, Live: live[f], Desired: desired[f]})
+		}
+	}
+
+	return diffs
+}
+
+// flatten collects the values nested in v by their dotted paths
+func flatten(prefix string, v interface{}, out map[string]interface{}) {
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		out[prefix] = v
+		return
+	}
+
+	for k, val := range m {
+		if prefix != "" {
+			k = prefix + "." + k
+		}
+		flatten(k, val, out)
+	}
+}
+
+// changeDiff is a change along with its property differences
+type changeDiff struct {
+	*Change
+	Diff []FieldDiff `json:"diff,omitempty"`
+}
+
+// Diff is the implementation of the `diff` command. It returns each planned
+// change with its property differences, the same as colorized (or plain)
+// text, and an error if any object has drifted from its manifest or couldn't
+// be compared with it.
+func Diff(ctx context.Context, path string, color bool) ([]byte, string, error) {
+	manifests, err := Load(path)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(manifests) == 0 {
+		return nil, "", fmt.Errorf("no manifests found in %s", path)
+	}
+
+	changes := Plan(ctx, manifests)
+
+	paint := func(c string, s string) string {
+		if !color {
+			return s
+		}
+		return c + s + colorReset
+	}
+
+	var diffs []changeDiff
+	var text strings.Builder
+	var drifted, failed int
+	for _, c := range changes {
+		d := changeDiff{c, c.diff()}
+		diffs = append(diffs, d)
+
+		ref := fmt.Sprintf("%s/%s", c.Kind, c.Name)
+		if c.ID != "" {
+			ref += fmt.Sprintf(" (%s)", c.ID)
+		}
+
+		switch c.Action {
+		case ActionCreate:
+			drifted++
+			text.WriteString(paint(colorGreen, fmt.Sprintf("+ %s would be created", ref)) + "\n")
+			for _, f := range d.Diff {
+				text.WriteString(paint(colorGreen, fmt.Sprintf("    + %s: %s", f.Field, value(f.Desired))) + "\n")
+			}
+		case ActionUpdate:
+			drifted++
+			text.WriteString(paint(colorYellow, fmt.Sprintf("~ %s would be updated", ref)) + "\n")
+			for _, f := range d.Diff {
+				text.WriteString(paint(colorRed, fmt.Sprintf("    - %s: %s", f.Field, value(f.Live))) + "\n")
+				text.WriteString(paint(colorGreen, fmt.Sprintf("    + %s: %s", f.Field, value(f.Desired))) + "\n")
+			}
+		case ActionUnchanged:
+			text.WriteString(fmt.Sprintf("  %s is unchanged\n", ref))
+		case ActionFailed:
+			failed++
+			text.WriteString(paint(colorRed, fmt.Sprintf("! %s (%s): %s", ref, c.Source, c.Error)) + "\n")
+		}
+	}
+	text.WriteString("\n" + summarize(changes))
+
+	j, _ := json.MarshalIndent(diffs, "", "    ")
+
+	if failed > 0 {
+		return j, text.String(), fmt.Errorf("%d of %d manifests couldn't be compared with the account", failed, len(changes))
+	}
+	if drifted > 0 {
+		return j, text.String(), &DriftError{Drifted: drifted, Total: len(changes)}
+	}
+
+	return j, text.String(), nil
+}
+
+// DriftError reports that objects on the account differ from their manifests,
+// so that drift can be told apart from a failure to compare them
+type DriftError struct {
+	Drifted int // the number of objects that would be created or updated
+	Total   int // the number of manifests
+}
+
+func (e *DriftError) Error() string {
+	return fmt.Sprintf("%d of %d objects differ from their manifests", e.Drifted, e.Total)
+}
+
+// value formats a property value for display
+func value(v interface{}) string {
+	if v == nil {
+		return "(none)"
+	}
+	b, _ := json.Marshal(v)
+
+	return string(b)
+}
@@ -0,0 +1,64 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	apiUserGroupList = func(ctx context.Context) (json.RawMessage, error) {
+		return []byte(`[{"user_group_id": "1", "display_name": "Ops"}, {"user_group_id": "2", "display_name": "Dev"}]`), nil
+	}
+	apiUserGroupGet = func(ctx context.Context, id string) (json.RawMessage, error) {
+		if id == "1" {
+			return []byte(`{"user_group_id": "1", "display_name": "Ops", "users": ["100"], "attribute_group_id": "9"}`), nil
+		}
+		return []byte(`{"user_group_id": "2", "display_name": "Dev", "users": ["200"], "attribute_group_id": "9"}`), nil
+	}
+
+	dir := t.TempDir()
+	write := func(name string, content string) {
+		os.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+	}
+
+	// No drift
+	write("dev.yaml", "kind: UserGroup\nspec:\n  display_name: Dev\n  users: [\"200\"]\n")
+	_, text, err := Diff(context.Background(), dir, false)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if !strings.Contains(text, "UserGroup/Dev (2) is unchanged") {
+		t.Errorf("Diff() = %q, want Dev unchanged", text)
+	}
+
+	// Drift
+	write("ops.yaml", "kind: UserGroup\nspec:\n  display_name: Ops\n  users: [\"100\", \"101\"]\n")
+	data, text, err := Diff(context.Background(), dir, false)
+	var drift *DriftError
+	if !errors.As(err, &drift) || !strings.Contains(err.Error(), "1 of 2 objects differ") {
+		t.Errorf("Diff() error = %v, want drift", err)
+	}
+
+	wantText := "~ UserGroup/Ops (1) would be updated\n" +
+		"    - users: [\"100\"]\n" +
+		"    + users: [\"100\",\"101\"]\n"
+	if !strings.Contains(text, wantText) {
+		t.Errorf("Diff() = %q, want %q", text, wantText)
+	}
+
+	var got []struct {
+		Name string      `json:"name"`
+		Diff []FieldDiff `json:"diff"`
+	}
+	json.Unmarshal(data, &got)
+	want := []FieldDiff{{Field: "users", Live: []interface{}{"100"}, Desired: []interface{}{"100", "101"}}}
+	if len(got) != 2 || !reflect.DeepEqual(got[1].Diff, want) {
+		t.Errorf("Diff() = %s, want a diff of %+v", data, want)
+	}
+}
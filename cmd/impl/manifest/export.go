@@ -0,0 +1,135 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"site24x7/api"
+	"site24x7/logger"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ActionExported reports that an object was written to a manifest
+const ActionExported = "exported"
+
+// unsafe matches runs of characters that don't belong in a file name
+var unsafe = regexp.MustCompile(`[^a-z0-9]+`)
+
+// Export is the implementation of the `export` command. It writes a manifest
+// for each object of every supported kind to a directory per kind, e.g.
+// <dir>/users/fred-example-com.yaml, overwriting any that already exist. Only
+// writable properties, and the object's identifier, are exported, so that the
+// manifests can be applied as they are.
+func Export(ctx context.Context, dir string) ([]byte, error) {
+	exported := []*Change{}
+	var failed []string
+
+	for _, k := range kinds {
+		changes, err := exportKind(ctx, k, dir)
+		exported = append(exported, changes...)
+		if err != nil {
+			logger.Warn(fmt.Sprintf("Unable to export %s objects (%s)", k.Name, err))
+			failed = append(failed, k.Name)
+		}
+	}
+
+	j, _ := json.MarshalIndent(exported, "", "    ")
+
+	if len(failed) > 0 {
+		return j, fmt.Errorf("unable to export %s objects", strings.Join(failed, ", "))
+	}
+
+	return j, nil
+}
+
+// exportKind writes a manifest for each object of a kind
+func exportKind(ctx context.Context, k *kind, dir string) ([]*Change, error) {
+	data, err := k.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("[manifest.exportKind] Unable to  parse response data (%s)", err)
+	}
+	if len(objects) == 0 {
+		return nil, nil
+	}
+
+	if err := os.MkdirAll(filepath.Join(dir, k.Dir), 0755); err != nil {
+		return nil, err
+	}
+
+	var changes []*Change
+	files := map[string]bool{}
+	for _, o := range objects {
+		id, _ := o[k.IDField].(string)
+
+		spec, err := exportSpec(ctx, k, id)
+		if err != nil {
+			return changes, err
+		}
+
+		name, _ := spec[k.KeyField].(string)
+		file := slug(name, id)
+		if files[file] {
+			file += "-" + id
+		}
+		files[file] = true
+		file = filepath.Join(dir, k.Dir, file+".yaml")
+
+		b, err := yaml.Marshal(Manifest{Kind: k.Name, Spec: spec})
+		if err != nil {
+			return changes, fmt.Errorf("[manifest.exportKind] Unable to format yaml (%s)", err)
+		}
+		if err := os.WriteFile(file, b, 0644); err != nil {
+			return changes, err
+		}
+
+		changes = append(changes, &Change{Kind: k.Name, Name: name, ID: id, Action: ActionExported, Source: file})
+	}
+
+	return changes, nil
+}
+
+// exportSpec returns the writable properties of an object, along with its
+// identifier
+func exportSpec(ctx context.Context, k *kind, id string) (map[string]interface{}, error) {
+	data, err := k.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	obj := k.New()
+	if err := json.Unmarshal(data, obj); err != nil {
+		return nil, fmt.Errorf("[manifest.exportSpec] Unable to  parse response data (%s)", err)
+	}
+
+	// Read-only properties, e.g. a user's zuid, aren't part of a request
+	b, err := api.RequestBody(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var spec map[string]interface{}
+	json.Unmarshal(b, &spec)
+	spec[k.IDField] = id
+
+	return spec, nil
+}
+
+// slug turns a name into a file name, e.g. "Web Servers" into web-servers
+func slug(name string, fallback string) string {
+	s := strings.Trim(unsafe.ReplaceAllString(strings.ToLower(name), "-"), "-")
+	if s == "" {
+		return fallback
+	}
+
+	return s
+}
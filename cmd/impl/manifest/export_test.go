@@ -0,0 +1,78 @@
+package manifest
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+)
+
+func Test_exportKind(t *testing.T) {
+	apiUserList = func(ctx context.Context) (json.RawMessage, error) {
+		return []byte(`[{"user_id": "1", "email_address": "fred@example.com"}]`), nil
+	}
+	apiUserGet = func(ctx context.Context, id string) (json.RawMessage, error) {
+		return []byte(`{
+			"user_id": "1",
+			"display_name": "Fred",
+			"email_address": "fred@example.com",
+			"user_role": 1,
+			"zuid": "123",
+			"is_edit_allowed": true,
+			"image_present": true
+		}`), nil
+	}
+
+	dir := t.TempDir()
+	k, _ := lookupKind("User")
+	changes, err := exportKind(context.Background(), k, dir)
+	if err != nil {
+		t.Fatalf("exportKind() error = %v", err)
+	}
+
+	file := filepath.Join(dir, "users", "fred-example-com.yaml")
+	if len(changes) != 1 || changes[0].Source != file || changes[0].ID != "1" {
+		t.Fatalf("exportKind() = %+v, want %s", changes, file)
+	}
+
+	// The manifest can be read back, minus any read-only properties
+	manifests, err := Load(file)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	spec := manifests[0].Spec
+	if manifests[0].Kind != "User" || spec["user_id"] != "1" || spec["display_name"] != "Fred" {
+		t.Errorf("exportKind() wrote %+v", manifests[0])
+	}
+	for _, p := range []string{"zuid", "is_edit_allowed", "image_present"} {
+		if _, ok := spec[p]; ok {
+			t.Errorf("exportKind() wrote the read-only %s property", p)
+		}
+	}
+
+	// An exported manifest is valid input for apply
+	if err := decodeStrict(mustJSON(spec), k.New()); err != nil {
+		t.Errorf("exportKind() wrote an invalid spec (%s)", err)
+	}
+}
+
+func Test_slug(t *testing.T) {
+	tests := map[string]string{
+		"Web Servers":      "web-servers",
+		"fred@example.com": "fred-example-com",
+		"  (Prod) API ":    "prod-api",
+		"日本":               "fallback",
+	}
+	for name, want := range tests {
+		if got := slug(name, "fallback"); got != want {
+			t.Errorf("slug(%q) = %s, want %s", name, got, want)
+		}
+	}
+}
+
+// mustJSON encodes a value as json
+func mustJSON(v interface{}) []byte {
+	b, _ := json.Marshal(v)
+
+	return b
+}
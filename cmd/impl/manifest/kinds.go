@@ -0,0 +1,225 @@
+package manifest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"strings"
+)
+
+// Alias upstream functions for mocking
+
+var apiUserList = api.UserList
+var apiUserGet = api.UserGet
+var apiUserCreate = api.UserCreate
+var apiUserUpdate = api.UserUpdate
+var apiUserGroupList = api.UserGroupList
+var apiUserGroupGet = api.UserGroupGet
+var apiUserGroupCreate = api.UserGroupCreate
+var apiUserGroupUpdate = api.UserGroupUpdate
+var apiMonitorGroupList = api.MonitorGroupList
+var apiMonitorGroupGet = api.MonitorGroupGet
+var apiMonitorGroupCreate = api.MonitorGroupCreate
+var apiMonitorGroupUpdate = api.MonitorGroupUpdate
+var apiMonitorList = api.MonitorList
+var apiMonitorGet = api.MonitorGet
+var apiMonitorCreate = api.MonitorCreate
+var apiMonitorUpdate = api.MonitorUpdate
+var apiLocationProfileList = api.LocationProfileList
+var apiLocationProfileGet = api.LocationProfileGet
+var apiLocationProfileCreate = api.LocationProfileCreate
+var apiLocationProfileUpdate = api.LocationProfileUpdate
+var apiNotificationProfileList = api.NotificationProfileList
+var apiNotificationProfileGet = api.NotificationProfileGet
+var apiNotificationProfileCreate = api.NotificationProfileCreate
+var apiNotificationProfileUpdate = api.NotificationProfileUpdate
+var apiThresholdProfileList = api.ThresholdProfileList
+var apiThresholdProfileGet = api.ThresholdProfileGet
+var apiThresholdProfileCreate = api.ThresholdProfileCreate
+var apiThresholdProfileUpdate = api.ThresholdProfileUpdate
+var apiMaintenanceWindowList = api.MaintenanceWindowList
+var apiMaintenanceWindowGet = api.MaintenanceWindowGet
+var apiMaintenanceWindowCreate = api.MaintenanceWindowCreate
+var apiMaintenanceWindowUpdate = api.MaintenanceWindowUpdate
+
+// kind describes how objects of one kind are identified and written
+type kind struct {
+	// Name is the kind as it appears in a manifest
+	Name string
+	// Dir is the directory to which objects of the kind are exported
+	Dir string
+	// IDField is the property holding an object's identifier
+	IDField string
+	// KeyField is the property by which an object is matched when its
+	// manifest doesn't include an identifier
+	KeyField string
+	// New returns a pointer to an empty object of the kind's api type
+	New    func() interface{}
+	List   func(ctx context.Context) (json.RawMessage, error)
+	Get    func(ctx context.Context, id string) (json.RawMessage, error)
+	Create func(ctx context.Context, v interface{}) (json.RawMessage, error)
+	Update func(ctx context.Context, v interface{}) (json.RawMessage, error)
+}
+
+// kinds lists the supported kinds of object in the order in which they're
+// applied; profiles come first so that they exist before anything that uses
+// them
+var kinds = []*kind{
+	{
+		Name:     "LocationProfile",
+		Dir:      "location_profiles",
+		IDField:  "profile_id",
+		KeyField: "profile_name",
+		New:      func() interface{} { return &api.LocationProfile{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiLocationProfileList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiLocationProfileGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiLocationProfileCreate(ctx, v.(*api.LocationProfile))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiLocationProfileUpdate(ctx, v.(*api.LocationProfile))
+		},
+	},
+	{
+		Name:     "NotificationProfile",
+		Dir:      "notification_profiles",
+		IDField:  "profile_id",
+		KeyField: "profile_name",
+		New:      func() interface{} { return &api.NotificationProfile{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiNotificationProfileList(ctx) },
+		Get: func(ctx context.Context, id string) (json.RawMessage, error) {
+			return apiNotificationProfileGet(ctx, id)
+		},
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiNotificationProfileCreate(ctx, v.(*api.NotificationProfile))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiNotificationProfileUpdate(ctx, v.(*api.NotificationProfile))
+		},
+	},
+	{
+		Name:     "ThresholdProfile",
+		Dir:      "threshold_profiles",
+		IDField:  "profile_id",
+		KeyField: "profile_name",
+		New:      func() interface{} { return &api.ThresholdProfile{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiThresholdProfileList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiThresholdProfileGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiThresholdProfileCreate(ctx, v.(*api.ThresholdProfile))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiThresholdProfileUpdate(ctx, v.(*api.ThresholdProfile))
+		},
+	},
+	{
+		Name:     "User",
+		Dir:      "users",
+		IDField:  "user_id",
+		KeyField: "email_address",
+		New:      func() interface{} { return &api.User{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiUserList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiUserGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiUserCreate(ctx, v.(*api.User))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiUserUpdate(ctx, v.(*api.User))
+		},
+	},
+	{
+		Name:     "UserGroup",
+		Dir:      "user_groups",
+		IDField:  "user_group_id",
+		KeyField: "display_name",
+		New:      func() interface{} { return &api.UserGroup{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiUserGroupList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiUserGroupGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiUserGroupCreate(ctx, v.(*api.UserGroup))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiUserGroupUpdate(ctx, v.(*api.UserGroup))
+		},
+	},
+	{
+		Name:     "MonitorGroup",
+		Dir:      "monitor_groups",
+		IDField:  "group_id",
+		KeyField: "display_name",
+		New:      func() interface{} { return &api.MonitorGroup{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiMonitorGroupList(ctx, false) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiMonitorGroupGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMonitorGroupCreate(ctx, v.(*api.MonitorGroup))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMonitorGroupUpdate(ctx, v.(*api.MonitorGroup))
+		},
+	},
+	{
+		Name:     "Monitor",
+		Dir:      "monitors",
+		IDField:  "monitor_id",
+		KeyField: "display_name",
+		New:      func() interface{} { return &api.Monitor{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiMonitorList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiMonitorGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMonitorCreate(ctx, v.(*api.Monitor))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMonitorUpdate(ctx, v.(*api.Monitor))
+		},
+	},
+	{
+		Name:     "MaintenanceWindow",
+		Dir:      "maintenance",
+		IDField:  "maintenance_id",
+		KeyField: "display_name",
+		New:      func() interface{} { return &api.MaintenanceWindow{} },
+		List:     func(ctx context.Context) (json.RawMessage, error) { return apiMaintenanceWindowList(ctx) },
+		Get:      func(ctx context.Context, id string) (json.RawMessage, error) { return apiMaintenanceWindowGet(ctx, id) },
+		Create: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMaintenanceWindowCreate(ctx, v.(*api.MaintenanceWindow))
+		},
+		Update: func(ctx context.Context, v interface{}) (json.RawMessage, error) {
+			return apiMaintenanceWindowUpdate(ctx, v.(*api.MaintenanceWindow))
+		},
+	},
+}
+
+// Kinds returns the names of the supported kinds of object
+func Kinds() []string {
+	var names []string
+	for _, k := range kinds {
+		names = append(names, k.Name)
+	}
+
+	return names
+}
+
+// lookupKind finds a kind by name; names are matched loosely, so user_group,
+// user-group and usergroup all identify the UserGroup kind
+func lookupKind(name string) (*kind, error) {
+	n := strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	for _, k := range kinds {
+		if strings.ToLower(k.Name) == n {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported kind (%s); expected one of %s", name, strings.Join(Kinds(), ", "))
+}
+
+// decodeStrict unmarshals json onto an object, rejecting any properties that
+// the object's type doesn't define; a typo in a manifest shouldn't silently be
+// ignored
+func decodeStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	return dec.Decode(v)
+}
@@ -0,0 +1,150 @@
+// The manifest/ package reconciles declarative descriptions of Site24x7
+// objects -- manifests -- with the live account. A manifest is a YAML or json
+// document naming a kind of object and its properties, which use the same
+// names as the Site24x7 API, e.g.
+//
+//	kind: UserGroup
+//	spec:
+//	  display_name: Operations
+//	  users: ["123456000000025005"]
+//	  attribute_group_id: "123456000000032001"
+
+package manifest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Manifest describes the desired state of a single object
+type Manifest struct {
+	Kind string                 `json:"kind" yaml:"kind"`
+	Spec map[string]interface{} `json:"spec" yaml:"spec"`
+	// Source identifies the file from which the manifest was read
+	Source string `json:"-" yaml:"-"`
+}
+
+// extensions lists the file extensions from which manifests are read
+var extensions = map[string]bool{".yaml": true, ".yml": true, ".json": true}
+
+// Load reads the manifests in a file or, recursively, a directory
+func Load(path string) ([]Manifest, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("[manifest.Load] Unable to read %s (%s)", path, err)
+	}
+	if !info.IsDir() {
+		return loadFile(path)
+	}
+
+	var manifests []Manifest
+	err = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !extensions[strings.ToLower(filepath.Ext(p))] {
+			return nil
+		}
+
+		m, err := loadFile(p)
+		if err != nil {
+			return err
+		}
+		manifests = append(manifests, m...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return manifests, nil
+}
+
+// loadFile reads the manifests in a file; a YAML file may hold several
+// documents and a json file either a single manifest or a list of them
+func loadFile(path string) ([]Manifest, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("[manifest.loadFile] Unable to read %s (%s)", path, err)
+	}
+
+	var docs []interface{}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, fmt.Errorf("invalid manifest %s (%s)", path, err)
+		}
+		if list, ok := v.([]interface{}); ok {
+			docs = list
+		} else {
+			docs = []interface{}{v}
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(b))
+		for {
+			var v interface{}
+			err := dec.Decode(&v)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("invalid manifest %s (%s)", path, err)
+			}
+			if v != nil {
+				docs = append(docs, normalize(v))
+			}
+		}
+	}
+
+	var manifests []Manifest
+	for i, doc := range docs {
+		source := path
+		if len(docs) > 1 {
+			source = fmt.Sprintf("%s[%d]", path, i)
+		}
+
+		// Round trip through json for the benefit of the api package types
+		j, _ := json.Marshal(doc)
+		var m Manifest
+		if err := json.Unmarshal(j, &m); err != nil {
+			return nil, fmt.Errorf("invalid manifest %s (%s)", source, err)
+		}
+		if m.Kind == "" || m.Spec == nil {
+			return nil, fmt.Errorf("invalid manifest %s; kind and spec are required", source)
+		}
+		m.Source = source
+
+		manifests = append(manifests, m)
+	}
+
+	return manifests, nil
+}
+
+// normalize converts the maps decoded from YAML, which may have keys of any
+// type, into maps that can be encoded as json
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, val := range t {
+			m[fmt.Sprint(k)] = normalize(val)
+		}
+
+		return m
+	case []interface{}:
+		for i, val := range t {
+			t[i] = normalize(val)
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,95 @@
+package manifest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"users.yaml": `kind: User
+spec:
+  email_address: fred@example.com
+  alert_settings:
+    email_format: 1
+---
+kind: User
+spec:
+  email_address: wilma@example.com
+`,
+		"groups/ops.json": `[{"kind": "UserGroup", "spec": {"display_name": "Ops"}}]`,
+		"README.md":       "not a manifest",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(p), 0700)
+		os.WriteFile(p, []byte(content), 0600)
+	}
+
+	got, err := Load(dir)
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+
+	want := []Manifest{
+		{
+			Kind:   "UserGroup",
+			Spec:   map[string]interface{}{"display_name": "Ops"},
+			Source: filepath.Join(dir, "groups/ops.json"),
+		},
+		{
+			Kind: "User",
+			Spec: map[string]interface{}{
+				"email_address":  "fred@example.com",
+				"alert_settings": map[string]interface{}{"email_format": 1},
+			},
+			Source: filepath.Join(dir, "users.yaml") + "[0]",
+		},
+		{
+			Kind:   "User",
+			Spec:   map[string]interface{}{"email_address": "wilma@example.com"},
+			Source: filepath.Join(dir, "users.yaml") + "[1]",
+		},
+	}
+
+	// Numbers arrive as float64 once round tripped through json
+	want[1].Spec["alert_settings"] = map[string]interface{}{"email_format": float64(1)}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Load() = %+v, want %+v", got, want)
+	}
+}
+
+func TestLoad_invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantErrMsg string
+	}{
+		{
+			name:       "Rejects invalid yaml",
+			content:    "kind: [User",
+			wantErrMsg: "invalid manifest",
+		},
+		{
+			name:       "Requires a kind and spec",
+			content:    "spec:\n  display_name: Ops\n",
+			wantErrMsg: "kind and spec are required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := filepath.Join(t.TempDir(), "manifest.yaml")
+			os.WriteFile(p, []byte(tt.content), 0600)
+
+			_, err := Load(p)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Load() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package manifest
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for changes
+var Table = output.Table{
+	{Header: "KIND", Value: output.Field("kind")},
+	{Header: "NAME", Value: output.Field("name")},
+	{Header: "ID", Value: output.Field("id")},
+	{Header: "ACTION", Value: output.Field("action")},
+	{Header: "ERROR", Value: output.Field("error")},
+}
+
+// ExportTable defines the default columns displayed for exported objects
+var ExportTable = output.Table{
+	{Header: "KIND", Value: output.Field("kind")},
+	{Header: "NAME", Value: output.Field("name")},
+	{Header: "ID", Value: output.Field("id")},
+	{Header: "FILE", Value: output.Field("source")},
+}
@@ -0,0 +1,101 @@
+package milestone
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/logger"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiMilestoneList = api.MilestoneList
+var apiMilestoneCreate = api.MilestoneCreate
+var apiMilestoneDelete = api.MilestoneDelete
+var resolveMonitors = monitor.ResolveAll
+var resolveMonitorGroups = monitorgroup.ResolveAll
+
+// now returns the current time; aliased for testing
+var now = time.Now
+
+// GetWriterFlags returns the flags of the `milestone add` command
+func GetWriterFlags() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("writer", pflag.ContinueOnError)
+	fs.String("name", "", "Name of the milestone, e.g. \"deploy v1.2.3\"")
+	fs.StringSlice("monitors", nil, "Monitors to mark the milestone on, by name or ID")
+	fs.StringSlice("group", nil, "Monitor groups to mark the milestone on, by name or ID")
+	fs.String("time", "", "When the milestone happened, as a time, e.g. 2021-06-01 09:00, or a duration ago, e.g. 15m (default now)")
+
+	return fs
+}
+
+// Add is the implementation of the `milestone add` command
+func Add(ctx context.Context, fs *pflag.FlagSet) error {
+	name, _ := fs.GetString("name")
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("a milestone requires a --name")
+	}
+
+	marked := now()
+	if t, _ := fs.GetString("time"); t != "" {
+		var err error
+		if marked, err = impl.ParseTime(t); err != nil {
+			return err
+		}
+	}
+
+	m := &api.Milestone{Name: name, MarkedTime: marked.Format(api.TimeLayout)}
+	var err error
+	monitors, _ := fs.GetStringSlice("monitors")
+	groups, _ := fs.GetStringSlice("group")
+	switch {
+	case len(monitors) > 0 && len(groups) > 0:
+		return fmt.Errorf("--monitors can't be combined with --group")
+	case len(monitors) > 0:
+		m.SelectionType = 2
+		if m.Monitors, err = resolveMonitors(ctx, monitors); err != nil {
+			return err
+		}
+	case len(groups) > 0:
+		m.SelectionType = 1
+		if m.MonitorGroups, err = resolveMonitorGroups(ctx, groups); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("a milestone requires either --monitors or --group")
+	}
+
+	logger.Info(fmt.Sprintf("[milestone.Add] Marking milestone %q at %s", m.Name, m.MarkedTime))
+
+	return apiMilestoneCreate(ctx, m)
+}
+
+// List is the implementation of the `milestone list` command
+func List(ctx context.Context) ([]byte, error) {
+	data, err := apiMilestoneList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var milestones []api.Milestone
+	if err = json.Unmarshal(data, &milestones); err != nil {
+		return nil, fmt.Errorf("[milestone.List] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(milestones, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `milestone delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiMilestoneDelete(ctx, id)
+}
@@ -0,0 +1,87 @@
+package milestone
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAdd(t *testing.T) {
+	now = func() time.Time { return time.Date(2021, 6, 1, 22, 0, 0, 0, time.UTC) }
+	resolveMonitors = func(ctx context.Context, refs []string) ([]string, error) {
+		if refs[0] == "Checkout API" {
+			return []string{"1"}, nil
+		}
+		return nil, errors.New("unknown monitor")
+	}
+	resolveMonitorGroups = func(ctx context.Context, refs []string) ([]string, error) {
+		return []string{"10"}, nil
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       *api.Milestone
+		wantErrMsg string
+	}{
+		{
+			name:       "Requires a name",
+			args:       []string{"--monitors", "Checkout API"},
+			wantErrMsg: "requires a --name",
+		},
+		{
+			name:       "Requires a target",
+			args:       []string{"--name", "deploy v1.2.3"},
+			wantErrMsg: "requires either --monitors or --group",
+		},
+		{
+			name:       "Rejects more than one kind of target",
+			args:       []string{"--name", "deploy v1.2.3", "--monitors", "Checkout API", "--group", "Production"},
+			wantErrMsg: "can't be combined",
+		},
+		{
+			name:       "Rejects an unknown monitor",
+			args:       []string{"--name", "deploy v1.2.3", "--monitors", "Basket API"},
+			wantErrMsg: "unknown monitor",
+		},
+		{
+			name: "Marks monitors now",
+			args: []string{"--name", "deploy v1.2.3", "--monitors", "Checkout API"},
+			want: &api.Milestone{Name: "deploy v1.2.3", MarkedTime: "2021-06-01T22:00:00+0000", SelectionType: 2, Monitors: []string{"1"}},
+		},
+		{
+			name: "Marks a monitor group in the past",
+			args: []string{"--name", "deploy v1.2.3", "--group", "Production", "--time", "2021-06-01T20:30:00Z"},
+			want: &api.Milestone{Name: "deploy v1.2.3", MarkedTime: "2021-06-01T20:30:00+0000", SelectionType: 1, MonitorGroups: []string{"10"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *api.Milestone
+			apiMilestoneCreate = func(ctx context.Context, m *api.Milestone) error {
+				got = m
+				return nil
+			}
+
+			fs := GetWriterFlags()
+			fs.Parse(tt.args)
+			err := Add(context.Background(), fs)
+			if tt.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Fatalf("Add() error = %v, want %q", err, tt.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Add() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Add() created %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package milestone
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for milestones
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("milestone_id")},
+	{Header: "NAME", Value: output.Field("milestone_name")},
+	{Header: "MARKED", Value: output.Field("marked_time")},
+	{Header: "TARGETS", Value: output.Lookup("selection_type", SelectionTypes)},
+}
+
+// SelectionTypes maps the kinds of resource a milestone can target to
+// friendly names
+// https://www.site24x7.com/help/api/#resource_type_constants
+var SelectionTypes = map[int]string{
+	0: "All monitors",
+	1: "Monitor groups",
+	2: "Monitors",
+	3: "Tags",
+}
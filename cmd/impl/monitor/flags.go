@@ -0,0 +1,207 @@
+package monitor
+
+import (
+	"fmt"
+	"site24x7/api"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.StringP("type", "t", "", "Monitor type: URL (website), RESTAPI, PING, PORT, DNS or SSL_CERT")
+	writerFlags.String("check-frequency", "", "Minutes between checks; see https://www.site24x7.com/help/api/#check_interval (default 5, or 1440 for SSL_CERT)")
+	writerFlags.Int("check-timeout", 0, "Seconds that a check waits for a response (default 30 for URL, RESTAPI and SSL_CERT, otherwise 10)")
+	writerFlags.String("location-profile", "", "Identifier of the location profile that determines where checks are made from")
+	writerFlags.String("notification-profile", "", "Identifier of the notification profile that determines how alerts are sent")
+	writerFlags.String("threshold-profile", "", "Identifier of the threshold profile that determines when alerts are raised")
+	writerFlags.StringSliceP("monitor-groups", "g", []string{}, "IDs or names of the monitor groups to which the monitor belongs")
+	writerFlags.StringSlice("user-groups", []string{}, "IDs or names of the user groups to be alerted")
+	writerFlags.StringSlice("tags", []string{}, "IDs or names of the tags to be associated with the monitor, e.g. env=prod")
+
+	// Website & REST API monitors
+	writerFlags.StringP("url", "u", "", "URL to be monitored (URL, RESTAPI)")
+	writerFlags.String("http-method", "", "HTTP method: GET, POST, HEAD, PUT, PATCH or DELETE (URL, RESTAPI; default GET)")
+	writerFlags.String("request-content-type", "", "Content type of the request body, e.g. JSON (RESTAPI)")
+	writerFlags.String("request-body", "", "Request body to send (RESTAPI)")
+	writerFlags.String("response-content-type", "", "Expected content type of the response, e.g. J for JSON (RESTAPI)")
+	writerFlags.Bool("match-case", false, "Perform case sensitive content checks (URL, RESTAPI)")
+	writerFlags.String("user-agent", "", "User agent to send (URL, RESTAPI)")
+
+	// Ping & port monitors
+	writerFlags.String("host", "", "Host name or IP address to be monitored (PING, PORT)")
+	writerFlags.Int("port", 0, "Port to be monitored (PORT, SSL_CERT; default 443 for SSL_CERT)")
+	writerFlags.Bool("use-ssl", false, "Connect using SSL (PORT)")
+	writerFlags.Bool("use-ipv6", false, "Monitor over IPv6 (PING, PORT)")
+
+	// DNS & SSL certificate monitors
+	writerFlags.String("domain", "", "Domain name to be resolved or whose certificate is monitored (DNS, SSL_CERT)")
+	writerFlags.String("dns-server", "", "Name server to query (DNS)")
+	writerFlags.String("dns-port", "", "Port of the name server (DNS; default 53)")
+	writerFlags.Int("lookup-type", 0, "Record type to look up; see https://www.site24x7.com/help/api/#dns_lookup_type (DNS; default 1, an A record)")
+	writerFlags.Int("expire-days", 0, "Days before expiry that an alert is raised (SSL_CERT; default 30)")
+
+	return writerFlags
+}
+
+// optional lists the flags whose properties are only sent when the flag is
+// given, so that an explicit false or 0 is sent but a default never is
+var optional = map[string]bool{
+	"check-timeout": true,
+	"port":          true,
+	"match-case":    true,
+	"use-ssl":       true,
+	"use-ipv6":      true,
+}
+
+// normalizeName maps a flag name to a property name
+func normalizeName(f *pflag.Flag) string {
+	switch f.Name {
+	case "url":
+		return "Website"
+	case "host":
+		return "HostName"
+	case "domain":
+		return "DomainName"
+	case "dns-server":
+		return "DNSHost"
+	case "location-profile":
+		return "LocationProfileID"
+	case "notification-profile":
+		return "NotificationProfileID"
+	case "threshold-profile":
+		return "ThresholdProfileID"
+	case "check-timeout":
+		// Distinct from the global --timeout of each API request
+		return "Timeout"
+
+	// The next few cases have abbreviations ("HTTP", "SSL", etc.) that we have
+	// to case manually
+	case "http-method":
+		return "HTTPMethod"
+	case "use-ssl":
+		return "UseSSL"
+	case "use-ipv6":
+		return "UseIPv6"
+	case "dns-port":
+		return "DNSPort"
+
+	// Everything else aligns pretty well with a "-" to CamelCase inflection
+	default:
+		t := cases.Title(language.English).String(f.Name)
+		return strings.Replace(t, "-", "", -1)
+	}
+}
+
+// normalizeType maps a monitor type or one of its aliases to a type code
+func normalizeType(t string) (string, error) {
+	if _, ok := Types[strings.ToUpper(t)]; ok {
+		return strings.ToUpper(t), nil
+	}
+	if code, ok := typeAliases[strings.ToLower(t)]; ok {
+		return code, nil
+	}
+
+	return "", fmt.Errorf("invalid monitor type (%s); expected one of URL, RESTAPI, PING, PORT, DNS or SSL_CERT", t)
+}
+
+// normalizeHTTPMethod maps an HTTP method to the code used by Site24x7
+func normalizeHTTPMethod(m string) (string, error) {
+	if code, ok := HTTPMethods[strings.ToUpper(m)]; ok {
+		return code, nil
+	}
+	for _, code := range HTTPMethods {
+		if strings.EqualFold(m, code) {
+			return code, nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid HTTP method (%s)", m)
+}
+
+// validate normalizes a monitor's type and HTTP method and ensures that the
+// properties its type requires are present
+func validate(m *api.Monitor) error {
+	t, err := normalizeType(m.Type)
+	if err != nil {
+		return err
+	}
+	m.Type = t
+
+	if m.HTTPMethod != "" {
+		if m.HTTPMethod, err = normalizeHTTPMethod(m.HTTPMethod); err != nil {
+			return err
+		}
+	}
+	if m.LookupType != 0 {
+		if _, ok := LookupTypes[m.LookupType]; !ok {
+			return fmt.Errorf("invalid lookup type (%d); see https://www.site24x7.com/help/api/#dns_lookup_type", m.LookupType)
+		}
+	}
+
+	switch m.Type {
+	case "URL", "RESTAPI":
+		if m.Website == "" {
+			return fmt.Errorf("a --url is required for %s monitors", m.Type)
+		}
+	case "PING":
+		if m.HostName == "" {
+			return fmt.Errorf("a --host is required for PING monitors")
+		}
+	case "PORT":
+		if m.HostName == "" || m.Port == nil {
+			return fmt.Errorf("a --host and a --port are required for PORT monitors")
+		}
+	case "DNS":
+		if m.DNSHost == "" || m.DomainName == "" {
+			return fmt.Errorf("a --dns-server and a --domain are required for DNS monitors")
+		}
+	case "SSL_CERT":
+		if m.DomainName == "" {
+			return fmt.Errorf("a --domain is required for SSL_CERT monitors")
+		}
+	}
+
+	return nil
+}
+
+// applyDefaults fills in any required properties of a new monitor that weren't
+// provided
+func applyDefaults(m *api.Monitor) {
+	d := defaults[m.Type]
+	if m.CheckFrequency == "" {
+		m.CheckFrequency = d.checkFrequency
+	}
+	if m.Timeout == nil {
+		timeout := d.timeout
+		m.Timeout = &timeout
+	}
+
+	switch m.Type {
+	case "URL", "RESTAPI":
+		if m.HTTPMethod == "" {
+			m.HTTPMethod = HTTPMethods["GET"]
+		}
+	case "DNS":
+		if m.DNSPort == "" {
+			m.DNSPort = "53"
+		}
+		if m.LookupType == 0 {
+			m.LookupType = 1
+		}
+	case "SSL_CERT":
+		if m.Port == nil {
+			port := 443
+			m.Port = &port
+		}
+		if m.ExpireDays == 0 {
+			m.ExpireDays = 30
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package monitor
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestGetWriterFlags(t *testing.T) {
+	fs := GetWriterFlags()
+
+	// The global --timeout bounds each API request; a monitor's own timeout
+	// mustn't shadow it
+	if fs.Lookup("timeout") != nil {
+		t.Errorf("GetWriterFlags() defines --timeout, which clashes with the global flag")
+	}
+	if fs.Lookup("check-timeout") == nil {
+		t.Errorf("GetWriterFlags() doesn't define --check-timeout")
+	}
+}
+
+func Test_normalizeName(t *testing.T) {
+	type args struct {
+		f *pflag.Flag
+	}
+
+	fs := GetWriterFlags()
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "Handles a multi-word flag",
+			args: args{
+				f: fs.Lookup("check-frequency"),
+			},
+			want: "CheckFrequency",
+		},
+		{
+			name: "Maps check-timeout to the monitor's timeout",
+			args: args{
+				f: fs.Lookup("check-timeout"),
+			},
+			want: "Timeout",
+		},
+		{
+			name: "Handles an abbreviation",
+			args: args{
+				f: fs.Lookup("use-ssl"),
+			},
+			want: "UseSSL",
+		},
+		{
+			name: "Maps a flag to a differently named property",
+			args: args{
+				f: fs.Lookup("url"),
+			},
+			want: "Website",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizeName(tt.args.f); got != tt.want {
+				t.Errorf("normalizeName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
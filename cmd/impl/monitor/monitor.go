@@ -0,0 +1,262 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/cmd/impl/tag"
+	"site24x7/cmd/impl/usergroup"
+	"site24x7/logger"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiMonitorList = api.MonitorList
+var apiMonitorGet = api.MonitorGet
+var apiMonitorCreate = api.MonitorCreate
+var apiMonitorUpdate = api.MonitorUpdate
+var apiMonitorDelete = api.MonitorDelete
+var apiMonitorActivate = api.MonitorActivate
+var apiMonitorSuspend = api.MonitorSuspend
+var resolveMonitorGroups = monitorgroup.ResolveAll
+var resolveUserGroups = usergroup.ResolveAll
+var resolveTags = tag.ResolveAll
+var parseSelector = tag.ParseSelector
+
+// list returns a slice containing all monitors on the account
+var list = func(ctx context.Context) ([]api.Monitor, error) {
+	data, err := apiMonitorList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var monitors []api.Monitor
+	if err = json.Unmarshal(data, &monitors); err != nil {
+		return nil, fmt.Errorf("[monitor.list] Unable to  parse response data (%s)", err)
+	}
+
+	return monitors, nil
+}
+
+// get fetches a monitor
+var get = func(ctx context.Context, id string) (*api.Monitor, error) {
+	var m api.Monitor
+
+	data, err := apiMonitorGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("[monitor.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &m, nil
+}
+
+// resolver translates monitor names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a monitor's display name
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "monitor",
+		Command: "monitor list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			monitors, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(monitors))
+			for i, m := range monitors {
+				refs[i] = impl.Reference{ID: m.ID, Names: []string{m.Name}}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// Resolve translates a monitor's display name (or ID) into its ID
+func Resolve(ctx context.Context, ref string) (string, error) {
+	return resolver.Resolve(ctx, ref)
+}
+
+// ResolveAll translates monitor display names (or IDs) into IDs
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
+
+// resolve replaces the group and tag names in a monitor with their IDs
+func resolve(ctx context.Context, m *api.Monitor) error {
+	var err error
+	if m.MonitorGroups, err = resolveMonitorGroups(ctx, m.MonitorGroups); err != nil {
+		return err
+	}
+	if m.UserGroups, err = resolveUserGroups(ctx, m.UserGroups); err != nil {
+		return err
+	}
+	if m.Tags, err = resolveTags(ctx, m.Tags); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Create is the implementation of the `monitor create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	m := &api.Monitor{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		if optional[f.Name] && !f.Changed {
+			return
+		}
+		property := normalizeName(f)
+		value := impl.TypedFlagValue(fs, f)
+
+		impl.SetProperty(m, property, value)
+	})
+
+	if err := resolve(ctx, m); err != nil {
+		return nil, err
+	}
+	if err := validate(m); err != nil {
+		return nil, err
+	}
+	applyDefaults(m)
+
+	data, err := apiMonitorCreate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated monitor struct
+	var mon api.Monitor
+	if err = json.Unmarshal(data, &mon); err != nil {
+		return nil, fmt.Errorf("[monitor.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(mon, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `monitor get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	m, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(m, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `monitor update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[monitor.Update] Updating monitor with ID %s", id))
+
+	m, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[monitor.Update] Fetched monitor %+v", m))
+
+	// Hydrate the monitor, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		property := normalizeName(f)
+		value := impl.TypedFlagValue(fs, f)
+
+		impl.SetProperty(m, property, value)
+	})
+
+	if err := resolve(ctx, m); err != nil {
+		return nil, err
+	}
+	if err := validate(m); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMonitorUpdate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated monitor struct
+	var mOut api.Monitor
+	if err = json.Unmarshal(data, &mOut); err != nil {
+		return nil, fmt.Errorf("[monitor.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(mOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `monitor delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiMonitorDelete(ctx, id)
+}
+
+// Activate is the implementation of the `monitor activate` command
+func Activate(ctx context.Context, id string) error {
+	return apiMonitorActivate(ctx, id)
+}
+
+// Suspend is the implementation of the `monitor suspend` command
+func Suspend(ctx context.Context, id string) error {
+	return apiMonitorSuspend(ctx, id)
+}
+
+// List is the implementation of the `monitor list` command
+func List(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
+	sel, err := parseSelector(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	monitors, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optionally narrow the list to monitors with matching tags
+	if sel != nil {
+		filtered := []api.Monitor{}
+		for _, m := range monitors {
+			if sel.Matches(m.Tags) {
+				filtered = append(filtered, m)
+			}
+		}
+		monitors = filtered
+	}
+
+	// Optionally narrow the list to a single monitor type
+	if t, _ := fs.GetString("type"); t != "" {
+		code, err := normalizeType(t)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := []api.Monitor{}
+		for _, m := range monitors {
+			if m.Type == code {
+				filtered = append(filtered, m)
+			}
+		}
+		monitors = filtered
+	}
+
+	j, _ := json.MarshalIndent(monitors, "", "    ")
+
+	return j, nil
+}
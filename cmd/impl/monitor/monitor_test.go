@@ -0,0 +1,435 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func Test_list(t *testing.T) {
+	mockAPIResponse := []byte(`[
+		{"display_name": "Test 1", "type": "URL"},
+		{"display_name": "Test 2", "type": "PING"}
+	]`)
+	mockList := []api.Monitor{
+		{Name: "Test 1", Type: "URL"},
+		{Name: "Test 2", Type: "PING"},
+	}
+
+	tests := []struct {
+		name       string
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
+		want       []api.Monitor
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return nil, errors.New("testing")
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Returns a list of monitors",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockList,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiMonitorList = tt.apiListFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := list(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("list() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	type args struct {
+		listType string
+	}
+
+	mockList := []api.Monitor{
+		{Name: "Test 1", Type: "URL"},
+		{Name: "Test 2", Type: "PING"},
+		{Name: "Test 3", Type: "URL"},
+	}
+	mockJSON, _ := json.MarshalIndent(mockList, "", "    ")
+	mockFilteredJSON, _ := json.MarshalIndent([]api.Monitor{mockList[0], mockList[2]}, "", "    ")
+
+	tests := []struct {
+		name       string
+		args       args
+		listFn     func(ctx context.Context) ([]api.Monitor, error)
+		want       []byte
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an error from the list function",
+			listFn: func(ctx context.Context) ([]api.Monitor, error) {
+				return nil, errors.New("testing")
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Returns a list of monitors",
+			listFn: func(ctx context.Context) ([]api.Monitor, error) {
+				return mockList, nil
+			},
+			want:    mockJSON,
+			wantErr: false,
+		},
+		{
+			name: "Filters the list by type",
+			args: args{listType: "website"},
+			listFn: func(ctx context.Context) ([]api.Monitor, error) {
+				return mockList, nil
+			},
+			want:    mockFilteredJSON,
+			wantErr: false,
+		},
+		{
+			name: "Rejects an invalid type",
+			args: args{listType: "carrier-pigeon"},
+			listFn: func(ctx context.Context) ([]api.Monitor, error) {
+				return mockList, nil
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "invalid monitor type",
+		},
+	}
+	for _, tt := range tests {
+		list = tt.listFn
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("testing", pflag.PanicOnError)
+			fs.String("type", tt.args.listType, "")
+
+			got, err := List(context.Background(), fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("List() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("List() = %v, want %v", string(got), string(tt.want))
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type args struct {
+		name  string
+		flags map[string]string
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		apiCreate  func(ctx context.Context, m *api.Monitor) (json.RawMessage, error)
+		want       *api.Monitor
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Requires the flags of the monitor type",
+			args: args{
+				name:  "Test Monitor",
+				flags: map[string]string{"type": "PORT", "host": "example.com"},
+			},
+			wantErr:    true,
+			wantErrMsg: "a --host and a --port are required",
+		},
+		{
+			name: "Handles an API error",
+			args: args{
+				name:  "Test Monitor",
+				flags: map[string]string{"type": "PING", "host": "example.com"},
+			},
+			apiCreate: func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+				return nil, errors.New("testing")
+			},
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Creates a website monitor with defaults",
+			args: args{
+				name:  "Test Monitor",
+				flags: map[string]string{"type": "website", "url": "https://example.com", "monitor-groups": "1,2"},
+			},
+			apiCreate: func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+				// return what was sent
+				j, _ := json.Marshal(m)
+
+				return j, nil
+			},
+			want: &api.Monitor{
+				Name:           "Test Monitor",
+				Type:           "URL",
+				Website:        "https://example.com",
+				HTTPMethod:     "G",
+				CheckFrequency: "5",
+				Timeout:        intPtr(30),
+				MonitorGroups:  []string{"1", "2"},
+				UserGroups:     []string{},
+				Tags:           []string{},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Creates an SSL certificate monitor with defaults",
+			args: args{
+				name:  "Test Monitor",
+				flags: map[string]string{"type": "ssl", "domain": "example.com"},
+			},
+			apiCreate: func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+				j, _ := json.Marshal(m)
+
+				return j, nil
+			},
+			want: &api.Monitor{
+				Name:           "Test Monitor",
+				Type:           "SSL_CERT",
+				DomainName:     "example.com",
+				Port:           intPtr(443),
+				ExpireDays:     30,
+				CheckFrequency: "1440",
+				Timeout:        intPtr(30),
+				MonitorGroups:  []string{},
+				UserGroups:     []string{},
+				Tags:           []string{},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiMonitorCreate = tt.apiCreate
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			for k, v := range tt.args.flags {
+				fs.Set(k, v)
+			}
+
+			got, err := Create(context.Background(), tt.args.name, fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Create() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			var m api.Monitor
+			json.Unmarshal(got, &m)
+			want, _ := json.Marshal(tt.want)
+			have, _ := json.Marshal(m)
+			if string(have) != string(want) {
+				t.Errorf("Create() = %s, want %s", have, want)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	mockAPIResponse := []byte(`{
+		"monitor_id": "1001001SOS",
+		"display_name": "TESTING",
+		"type": "URL",
+		"website": "https://example.com"
+	}`)
+	var mockMonitor api.Monitor
+	json.Unmarshal(mockAPIResponse, &mockMonitor)
+	mockJSON, _ := json.MarshalIndent(mockMonitor, "", "    ")
+
+	tests := []struct {
+		name       string
+		apiGetFn   func(ctx context.Context, id string) (json.RawMessage, error)
+		want       []byte
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return nil, &api.NotFoundError{Message: "monitor not found"}
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "monitor not found",
+		},
+		{
+			name: "Returns formatted json",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockJSON,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiMonitorGet = tt.apiGetFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(context.Background(), "1001001SOS")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Get() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fs := GetWriterFlags()
+
+	tests := []struct {
+		name        string
+		before      func()
+		getFn       func(ctx context.Context, id string) (*api.Monitor, error)
+		apiUpdateFn func(ctx context.Context, m *api.Monitor) (json.RawMessage, error)
+		want        *api.Monitor
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name:   "Handles an error from the get function",
+			before: func() {},
+			getFn: func(ctx context.Context, id string) (*api.Monitor, error) {
+				return nil, errors.New("testing")
+			},
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Updates ONLY the flags that were set",
+			before: func() {
+				fs.Set("http-method", "post")
+				fs.Set("check-timeout", "15")
+			},
+			getFn: func(ctx context.Context, id string) (*api.Monitor, error) {
+				return &api.Monitor{ID: id, Name: "Test", Type: "URL", Website: "https://example.com", HTTPMethod: "G", Timeout: intPtr(30)}, nil
+			},
+			apiUpdateFn: func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+				j, _ := json.Marshal(m)
+
+				return j, nil
+			},
+			want:    &api.Monitor{ID: "1001001SOS", Name: "Test", Type: "URL", Website: "https://example.com", HTTPMethod: "P", Timeout: intPtr(15)},
+			wantErr: false,
+		},
+		{
+			name: "Sends a flag that was set to false",
+			before: func() {
+				fs.Set("match-case", "false")
+			},
+			getFn: func(ctx context.Context, id string) (*api.Monitor, error) {
+				return &api.Monitor{ID: id, Name: "Test", Type: "URL", Website: "https://example.com", HTTPMethod: "G", Timeout: intPtr(30), MatchCase: boolPtr(true)}, nil
+			},
+			apiUpdateFn: func(ctx context.Context, m *api.Monitor) (json.RawMessage, error) {
+				j, _ := json.Marshal(m)
+
+				return j, nil
+			},
+			want:    &api.Monitor{ID: "1001001SOS", Name: "Test", Type: "URL", Website: "https://example.com", HTTPMethod: "P", Timeout: intPtr(15), MatchCase: boolPtr(false)},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		get = tt.getFn
+		apiMonitorUpdate = tt.apiUpdateFn
+		t.Run(tt.name, func(t *testing.T) {
+			tt.before()
+			got, err := Update(context.Background(), "1001001SOS", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Update() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			want, _ := json.MarshalIndent(tt.want, "", "    ")
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Update() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tests := []struct {
+		name        string
+		apiDeleteFn func(ctx context.Context, id string) error
+		wantErr     bool
+	}{
+		{
+			name: "Handles an API error",
+			apiDeleteFn: func(ctx context.Context, id string) error {
+				return errors.New("testing")
+			},
+			wantErr: true,
+		},
+		{
+			name: "Returns successfully",
+			apiDeleteFn: func(ctx context.Context, id string) error {
+				return nil
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiMonitorDelete = tt.apiDeleteFn
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Delete(context.Background(), "1001001SOS"); (err != nil) != tt.wantErr {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
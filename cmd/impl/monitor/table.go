@@ -0,0 +1,24 @@
+package monitor
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for monitors
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("monitor_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "TYPE", Value: output.Field("type")},
+	{Header: "STATE", Value: output.Lookup("state", States)},
+	{Header: "TARGET", Value: target},
+	{Header: "CHECK FREQUENCY", Value: output.Field("check_frequency")},
+}
+
+// target displays whatever a monitor is pointed at, which depends on its type
+func target(row map[string]interface{}) string {
+	for _, path := range []string{"website", "host_name", "domain_name"} {
+		if v := output.Field(path)(row); v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,70 @@
+package monitor
+
+// Types maps the supported monitor type codes to friendly names
+// https://www.site24x7.com/help/api/#monitor-types
+var Types = map[string]string{
+	"URL":      "Website",
+	"RESTAPI":  "REST API",
+	"PING":     "Ping",
+	"PORT":     "Port",
+	"DNS":      "DNS Server",
+	"SSL_CERT": "SSL/TLS Certificate",
+}
+
+// typeAliases lets a monitor type be given by a friendlier name
+var typeAliases = map[string]string{
+	"website":  "URL",
+	"rest-api": "RESTAPI",
+	"rest":     "RESTAPI",
+	"api":      "RESTAPI",
+	"ssl":      "SSL_CERT",
+	"ssl-cert": "SSL_CERT",
+	"tls":      "SSL_CERT",
+}
+
+// States maps monitor state ids to friendly names
+// https://www.site24x7.com/help/api/#monitor_state_constants
+var States = map[int]string{
+	0: "Active",
+	5: "Suspended",
+}
+
+// HTTPMethods maps the HTTP methods accepted by website and REST API monitors
+// to the codes that Site24x7 uses for them
+// https://www.site24x7.com/help/api/#http_methods
+var HTTPMethods = map[string]string{
+	"GET":    "G",
+	"POST":   "P",
+	"HEAD":   "H",
+	"PUT":    "U",
+	"PATCH":  "A",
+	"DELETE": "D",
+}
+
+// LookupTypes maps DNS lookup type ids to record types
+// https://www.site24x7.com/help/api/#dns_lookup_type
+var LookupTypes = map[int]string{
+	1:  "A",
+	2:  "NS",
+	5:  "CNAME",
+	6:  "SOA",
+	12: "PTR",
+	15: "MX",
+	16: "TXT",
+	28: "AAAA",
+	33: "SRV",
+}
+
+// defaults defines sensible values for properties that a new monitor of a given
+// type requires but that weren't provided
+var defaults = map[string]struct {
+	checkFrequency string
+	timeout        int
+}{
+	"URL":      {"5", 30},
+	"RESTAPI":  {"5", 30},
+	"PING":     {"5", 10},
+	"PORT":     {"5", 10},
+	"DNS":      {"5", 10},
+	"SSL_CERT": {"1440", 30},
+}
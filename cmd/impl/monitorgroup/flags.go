@@ -18,6 +18,7 @@ func GetWriterFlags() *pflag.FlagSet {
 	writerFlags.Int("health-threshold", 1, "Number of monitors' health that decide the group status.")
 	writerFlags.StringSlice("dependent-monitors", []string{}, "Identifiers of dependent monitors")
 	writerFlags.Bool("suppress-alert", false, "Suppress alert when a dependent monitor is down")
+	writerFlags.StringSlice("tags", []string{}, "IDs or names of the tags to be associated with the group, e.g. env=prod")
 
 	return writerFlags
 }
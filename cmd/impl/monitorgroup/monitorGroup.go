@@ -1,10 +1,12 @@
 package monitorgroup
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"site24x7/api"
 	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/tag"
 	"site24x7/logger"
 
 	"github.com/spf13/pflag"
@@ -17,10 +19,12 @@ var apiMonitorGroupGet = api.MonitorGroupGet
 var apiMonitorGroupCreate = api.MonitorGroupCreate
 var apiMonitorGroupUpdate = api.MonitorGroupUpdate
 var apiMonitorGroupDelete = api.MonitorGroupDelete
+var resolveTags = tag.ResolveAll
+var parseSelector = tag.ParseSelector
 
 // list returns a slice containing all users on the account
-var list = func(withSubgroups bool) ([]api.MonitorGroup, error) {
-	data, err := apiMonitorGroupList(withSubgroups)
+var list = func(ctx context.Context, withSubgroups bool) ([]api.MonitorGroup, error) {
+	data, err := apiMonitorGroupList(ctx, withSubgroups)
 	if err != nil {
 		return nil, err
 	}
@@ -34,10 +38,10 @@ var list = func(withSubgroups bool) ([]api.MonitorGroup, error) {
 }
 
 // get fetches a monitor group
-var get = func(id string) (*api.MonitorGroup, error) {
+var get = func(ctx context.Context, id string) (*api.MonitorGroup, error) {
 	var mg api.MonitorGroup
 
-	data, err := apiMonitorGroupGet(id)
+	data, err := apiMonitorGroupGet(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -50,8 +54,49 @@ var get = func(id string) (*api.MonitorGroup, error) {
 	return &mg, nil
 }
 
+// resolver translates monitor group names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a monitor group's display name,
+// including those of subgroups
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "monitor group",
+		Command: "monitor_group list --with-subgroups",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			mongrus, err := list(ctx, true)
+			if err != nil {
+				return nil, err
+			}
+
+			return references(mongrus, nil), nil
+		},
+	}
+}
+
+// references appends a reference to each monitor group, and to each of its
+// subgroups at any depth, to refs
+func references(mongrus []api.MonitorGroup, refs []impl.Reference) []impl.Reference {
+	for _, mg := range mongrus {
+		refs = append(refs, impl.Reference{ID: mg.ID, Names: []string{mg.Name}})
+		refs = references(mg.Subgroups, refs)
+	}
+
+	return refs
+}
+
+// Resolve translates a monitor group's display name (or ID) into its ID
+func Resolve(ctx context.Context, ref string) (string, error) {
+	return resolver.Resolve(ctx, ref)
+}
+
+// ResolveAll translates monitor group display names (or IDs) into IDs
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
+
 // Create is the implementation of the `monitor_group create` command
-func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
 	mg := &api.MonitorGroup{Name: name}
 	fs.VisitAll(func(f *pflag.Flag) {
 		property := normalizeName(f)
@@ -60,7 +105,12 @@ func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
 		impl.SetProperty(mg, property, value)
 	})
 
-	data, err := apiMonitorGroupCreate(mg)
+	var err error
+	if mg.Tags, err = resolveTags(ctx, mg.Tags); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMonitorGroupCreate(ctx, mg)
 	if err != nil {
 		return nil, err
 	}
@@ -78,8 +128,8 @@ func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Get is the implementation of the `monitor_group get` command
-func Get(id string, fs *pflag.FlagSet) ([]byte, error) {
-	mg, err := get(id)
+func Get(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	mg, err := get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -90,10 +140,10 @@ func Get(id string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Update is the implementation of the `monitor_group update` command
-func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
 	logger.Info(fmt.Sprintf("[MonitorGroup.Update] Updating group with ID %s", id))
 
-	mg, err := get(id)
+	mg, err := get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -108,7 +158,11 @@ func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
 		impl.SetProperty(mg, property, value)
 	})
 
-	data, err := apiMonitorGroupUpdate(mg)
+	if mg.Tags, err = resolveTags(ctx, mg.Tags); err != nil {
+		return nil, err
+	}
+
+	data, err := apiMonitorGroupUpdate(ctx, mg)
 	if err != nil {
 		return nil, err
 	}
@@ -125,8 +179,8 @@ func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Delete is the implementation of the `monitor_group delete` command
-func Delete(id string, fs *pflag.FlagSet) error {
-	err := apiMonitorGroupDelete(id)
+func Delete(ctx context.Context, id string, fs *pflag.FlagSet) error {
+	err := apiMonitorGroupDelete(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -135,14 +189,30 @@ func Delete(id string, fs *pflag.FlagSet) error {
 }
 
 // List is the implementation of the `monitor_group list` command
-func List(fs *pflag.FlagSet) ([]byte, error) {
+func List(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
 	sg, _ := fs.GetBool("with-subgroups")
 
-	mongrus, err := list(sg)
+	sel, err := parseSelector(ctx, fs)
 	if err != nil {
 		return nil, err
 	}
 
+	mongrus, err := list(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Optionally narrow the list to groups with matching tags
+	if sel != nil {
+		filtered := []api.MonitorGroup{}
+		for _, mg := range mongrus {
+			if sel.Matches(mg.Tags) {
+				filtered = append(filtered, mg)
+			}
+		}
+		mongrus = filtered
+	}
+
 	j, _ := json.MarshalIndent(mongrus, "", "    ")
 
 	return j, nil
@@ -1,6 +1,7 @@
 package monitorgroup
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"reflect"
@@ -30,14 +31,14 @@ func Test_list(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		apiListFn  func(withSubgroups bool) (json.RawMessage, error)
+		apiListFn  func(ctx context.Context, withSubgroups bool) (json.RawMessage, error)
 		want       []api.MonitorGroup
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "Handles an API error",
-			apiListFn: func(withSubgroups bool) (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context, withSubgroups bool) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -46,7 +47,7 @@ func Test_list(t *testing.T) {
 		},
 		{
 			name: "Returns a list of monitor groups",
-			apiListFn: func(withSubgroups bool) (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context, withSubgroups bool) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockList,
@@ -56,7 +57,7 @@ func Test_list(t *testing.T) {
 	for _, tt := range tests {
 		apiMonitorGroupList = tt.apiListFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := list(tt.args.withSubgroups)
+			got, err := list(context.Background(), tt.args.withSubgroups)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -86,7 +87,7 @@ func TestList(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		listFn     func(withSubgroups bool) ([]api.MonitorGroup, error)
+		listFn     func(ctx context.Context, withSubgroups bool) ([]api.MonitorGroup, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
@@ -96,7 +97,7 @@ func TestList(t *testing.T) {
 			args: args{
 				fs: pflag.NewFlagSet("testing", pflag.PanicOnError),
 			},
-			listFn: func(withSubgroups bool) ([]api.MonitorGroup, error) {
+			listFn: func(ctx context.Context, withSubgroups bool) ([]api.MonitorGroup, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -108,7 +109,7 @@ func TestList(t *testing.T) {
 			args: args{
 				fs: pflag.NewFlagSet("testing", pflag.PanicOnError),
 			},
-			listFn: func(withSubgroups bool) ([]api.MonitorGroup, error) {
+			listFn: func(ctx context.Context, withSubgroups bool) ([]api.MonitorGroup, error) {
 				return mockList, nil
 			},
 			want:    mockJSON,
@@ -118,7 +119,7 @@ func TestList(t *testing.T) {
 	for _, tt := range tests {
 		list = tt.listFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := List(tt.args.fs)
+			got, err := List(context.Background(), tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -133,6 +134,62 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestResolveAll(t *testing.T) {
+	mockGroups := []api.MonitorGroup{
+		{ID: "1", Name: "Production", Subgroups: []api.MonitorGroup{
+			{ID: "2", Name: "Web", Subgroups: []api.MonitorGroup{
+				{ID: "3", Name: "Checkout"},
+			}},
+		}},
+		{ID: "4", Name: "Staging"},
+	}
+
+	listFn := list
+	t.Cleanup(func() { list = listFn })
+	list = func(ctx context.Context, withSubgroups bool) ([]api.MonitorGroup, error) {
+		if !withSubgroups {
+			return mockGroups[1:], nil
+		}
+		return mockGroups, nil
+	}
+
+	tests := []struct {
+		name       string
+		refs       []string
+		want       []string
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Resolves groups and nested subgroups by name",
+			refs: []string{"production", "Web", "checkout", "Staging"},
+			want: []string{"1", "2", "3", "4"},
+		},
+		{
+			name:       "Rejects an unknown group",
+			refs:       []string{"Sandbox"},
+			wantErr:    true,
+			wantErrMsg: "unknown monitor group (Sandbox)",
+		},
+	}
+	for _, tt := range tests {
+		resolver = newResolver()
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveAll(context.Background(), tt.refs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ResolveAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("ResolveAll() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestCreate(t *testing.T) {
 	type args struct {
 		name string
@@ -152,7 +209,7 @@ func TestCreate(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		apiCreateFn func(u *api.MonitorGroup) (json.RawMessage, error)
+		apiCreateFn func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error)
 		want        []byte
 		wantErr     bool
 		wantErrMsg  string
@@ -163,7 +220,7 @@ func TestCreate(t *testing.T) {
 				name: "Test Group",
 				fs:   GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.MonitorGroup) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -176,7 +233,7 @@ func TestCreate(t *testing.T) {
 				name: "Test Group",
 				fs:   GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.MonitorGroup) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockMonitorGroupJSON,
@@ -186,7 +243,7 @@ func TestCreate(t *testing.T) {
 	for _, tt := range tests {
 		apiMonitorGroupCreate = tt.apiCreateFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Create(tt.args.name, tt.args.fs)
+			got, err := Create(context.Background(), tt.args.name, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -223,7 +280,7 @@ func TestGet(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		apiGetFn   func(id string) (json.RawMessage, error)
+		apiGetFn   func(ctx context.Context, id string) (json.RawMessage, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
@@ -234,7 +291,7 @@ func TestGet(t *testing.T) {
 				id: "1001001SOS",
 				fs: mockFlagSet,
 			},
-			apiGetFn: func(id string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -247,7 +304,7 @@ func TestGet(t *testing.T) {
 				id: "1001001SOS",
 				fs: mockFlagSet,
 			},
-			apiGetFn: func(id string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockJSON,
@@ -257,7 +314,7 @@ func TestGet(t *testing.T) {
 	for _, tt := range tests {
 		apiMonitorGroupGet = tt.apiGetFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Get(tt.args.id, tt.args.fs)
+			got, err := Get(context.Background(), tt.args.id, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -294,8 +351,8 @@ func TestUpdate(t *testing.T) {
 		name             string
 		args             args
 		before           func()
-		getFn            func(id string) (*api.MonitorGroup, error)
-		apiGroupUpdateFn func(u *api.MonitorGroup) (json.RawMessage, error)
+		getFn            func(ctx context.Context, id string) (*api.MonitorGroup, error)
+		apiGroupUpdateFn func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error)
 		want             []byte
 		wantErr          bool
 		wantErrMsg       string
@@ -309,7 +366,7 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string) (*api.MonitorGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.MonitorGroup, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -325,10 +382,10 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string) (*api.MonitorGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.MonitorGroup, error) {
 				return mockGroup, nil
 			},
-			apiGroupUpdateFn: func(u *api.MonitorGroup) (json.RawMessage, error) {
+			apiGroupUpdateFn: func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -350,10 +407,10 @@ func TestUpdate(t *testing.T) {
 				// this flag should be ignored
 				fs.Set("ignore-me", "boo!")
 			},
-			getFn: func(id string) (*api.MonitorGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.MonitorGroup, error) {
 				return mockGroup, nil
 			},
-			apiGroupUpdateFn: func(u *api.MonitorGroup) (json.RawMessage, error) {
+			apiGroupUpdateFn: func(ctx context.Context, u *api.MonitorGroup) (json.RawMessage, error) {
 				// return what was sent
 				j, _ := json.Marshal(u)
 
@@ -368,7 +425,7 @@ func TestUpdate(t *testing.T) {
 		apiMonitorGroupUpdate = tt.apiGroupUpdateFn
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
-			got, err := Update(tt.args.id, tt.args.fs)
+			got, err := Update(context.Background(), tt.args.id, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -394,7 +451,7 @@ func TestDelete(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		apiDeleteFn func(id string) error
+		apiDeleteFn func(ctx context.Context, id string) error
 		wantErr     bool
 		wantErrMsg  string
 	}{
@@ -404,7 +461,7 @@ func TestDelete(t *testing.T) {
 				id: "1001001SOS",
 				fs: mockFlagSet,
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return errors.New("testing")
 			},
 			wantErr:    true,
@@ -416,7 +473,7 @@ func TestDelete(t *testing.T) {
 				id: "1001001SOS",
 				fs: mockFlagSet,
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return nil
 			},
 			wantErr: false,
@@ -425,7 +482,7 @@ func TestDelete(t *testing.T) {
 	for _, tt := range tests {
 		apiMonitorGroupDelete = tt.apiDeleteFn
 		t.Run(tt.name, func(t *testing.T) {
-			err := Delete(tt.args.id, tt.args.fs)
+			err := Delete(context.Background(), tt.args.id, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -0,0 +1,13 @@
+package monitorgroup
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for monitor groups
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("group_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "DESCRIPTION", Value: output.Field("description")},
+	{Header: "MONITORS", Value: output.Count("monitors")},
+	{Header: "HEALTH THRESHOLD", Value: output.Field("health_threshold_count")},
+	{Header: "SUBGROUPS", Value: output.Count("subgroups")},
+}
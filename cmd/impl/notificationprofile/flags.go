@@ -0,0 +1,83 @@
+package notificationprofile
+
+import (
+	"fmt"
+	"site24x7/api"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.Bool("rca", false, "Send a root cause analysis report with downtime alerts")
+	writerFlags.Bool("notify-after-actions", false, "Only send alerts once any IT automations have been executed")
+	writerFlags.Bool("suppress-automation", false, "Don't execute IT automations for dependent monitors")
+	writerFlags.Int("downtime-delay", 0, "Number of polls for which a monitor must be down before an alert is sent")
+	writerFlags.Int("persistent-alerts", 0, "Repeat downtime alerts every N polls until the monitor is back up; 0 to alert once")
+	writerFlags.String("escalation-user-group", "", "ID or name of the user group that's alerted when downtime isn't resolved in time")
+	writerFlags.Int("escalation-wait", 0, "Minutes to wait before escalating a downtime")
+	writerFlags.StringSlice("escalation-automations", []string{}, "IDs of IT automations executed on escalation")
+	writerFlags.StringSlice("escalation-services", []string{}, "IDs of third party services alerted on escalation")
+	writerFlags.String("template", "", "ID of the email template used for alerts")
+
+	return writerFlags
+}
+
+// normalizeName maps a flag name to a property name
+func normalizeName(f *pflag.Flag) string {
+	switch f.Name {
+	// Handle known exceptions to the rule
+	case "rca":
+		return "RCANeeded"
+	case "notify-after-actions":
+		return "NotifyAfterExecutingActions"
+	case "downtime-delay":
+		return "DowntimeNotificationDelay"
+	case "persistent-alerts":
+		return "PersistentNotification"
+	case "escalation-wait":
+		return "EscalationWaitTime"
+
+	// Everything else aligns pretty well with a "-" to CamelCase inflection
+	default:
+		t := cases.Title(language.English).String(f.Name)
+		return strings.Replace(t, "-", "", -1)
+	}
+}
+
+// validateWriters validates writable values passed to the command via flags.
+// Only flags that were changed are validated; we should be able to safely
+// assume that default values are valid.
+func validateWriters(fs *pflag.FlagSet) error {
+	var err error
+
+	fs.Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "downtime-delay", "persistent-alerts", "escalation-wait":
+			if v, _ := fs.GetInt(f.Name); v < 0 {
+				err = fmt.Errorf("--%s can't be negative", f.Name)
+			}
+		}
+	})
+
+	return err
+}
+
+// validate checks the consistency of a fully hydrated profile
+func validate(np *api.NotificationProfile) error {
+	hasEscalation := np.EscalationWaitTime > 0 || len(np.EscalationAutomations) > 0 || len(np.EscalationServices) > 0
+	if hasEscalation && np.EscalationUserGroup == "" {
+		return fmt.Errorf("escalation requires --escalation-user-group")
+	}
+	if np.EscalationUserGroup != "" && np.EscalationWaitTime == 0 {
+		return fmt.Errorf("escalation requires --escalation-wait")
+	}
+
+	return nil
+}
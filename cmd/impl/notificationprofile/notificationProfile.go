@@ -0,0 +1,162 @@
+package notificationprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/usergroup"
+	"site24x7/logger"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiNotificationProfileList = api.NotificationProfileList
+var apiNotificationProfileGet = api.NotificationProfileGet
+var apiNotificationProfileCreate = api.NotificationProfileCreate
+var apiNotificationProfileUpdate = api.NotificationProfileUpdate
+var apiNotificationProfileDelete = api.NotificationProfileDelete
+var resolveUserGroup = usergroup.Resolve
+
+// list returns a slice containing all notification profiles on the account
+var list = func(ctx context.Context) ([]api.NotificationProfile, error) {
+	data, err := apiNotificationProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []api.NotificationProfile
+	if err = json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("[notificationprofile.list] Unable to  parse response data (%s)", err)
+	}
+
+	return profiles, nil
+}
+
+// get fetches a notification profile
+var get = func(ctx context.Context, id string) (*api.NotificationProfile, error) {
+	var np api.NotificationProfile
+
+	data, err := apiNotificationProfileGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &np); err != nil {
+		return nil, fmt.Errorf("[notificationprofile.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &np, nil
+}
+
+// Create is the implementation of the `notification_profile create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	np := &api.NotificationProfile{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		impl.SetProperty(np, normalizeName(f), impl.TypedFlagValue(fs, f))
+	})
+
+	var err error
+	if np.EscalationUserGroup, err = resolveUserGroup(ctx, np.EscalationUserGroup); err != nil {
+		return nil, err
+	}
+	if err := validate(np); err != nil {
+		return nil, err
+	}
+
+	data, err := apiNotificationProfileCreate(ctx, np)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var profile api.NotificationProfile
+	if err = json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("[notificationprofile.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(profile, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `notification_profile get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	np, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(np, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `notification_profile update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[notificationprofile.Update] Updating profile with ID %s", id))
+
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	np, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[notificationprofile.Update] Fetched profile %+v", np))
+
+	// Hydrate the profile, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		impl.SetProperty(np, normalizeName(f), impl.TypedFlagValue(fs, f))
+	})
+
+	if np.EscalationUserGroup, err = resolveUserGroup(ctx, np.EscalationUserGroup); err != nil {
+		return nil, err
+	}
+	if err := validate(np); err != nil {
+		return nil, err
+	}
+
+	data, err := apiNotificationProfileUpdate(ctx, np)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var npOut api.NotificationProfile
+	if err = json.Unmarshal(data, &npOut); err != nil {
+		return nil, fmt.Errorf("[notificationprofile.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(npOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `notification_profile delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiNotificationProfileDelete(ctx, id)
+}
+
+// List is the implementation of the `notification_profile list` command
+func List(ctx context.Context) ([]byte, error) {
+	profiles, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(profiles, "", "    ")
+
+	return j, nil
+}
@@ -0,0 +1,255 @@
+package notificationprofile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+func Test_list(t *testing.T) {
+	mockAPIResponse := []byte(`[
+		{"profile_id": "1", "profile_name": "Test 1", "rca_needed": true},
+		{"profile_id": "2", "profile_name": "Test 2", "persistent_notification": 3}
+	]`)
+	mockList := []api.NotificationProfile{
+		{ID: "1", Name: "Test 1", RCANeeded: true},
+		{ID: "2", Name: "Test 2", PersistentNotification: 3},
+	}
+
+	tests := []struct {
+		name       string
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
+		want       []api.NotificationProfile
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return nil, errors.New("testing")
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Returns a list of notification profiles",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockList,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiNotificationProfileList = tt.apiListFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := list(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("list() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		apiCreateFn func(ctx context.Context, np *api.NotificationProfile) (json.RawMessage, error)
+		want        *api.NotificationProfile
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name:       "Rejects a negative delay",
+			flags:      map[string]string{"downtime-delay": "-1"},
+			wantErr:    true,
+			wantErrMsg: "--downtime-delay can't be negative",
+		},
+		{
+			name:       "Rejects an escalation without a user group",
+			flags:      map[string]string{"escalation-wait": "30"},
+			wantErr:    true,
+			wantErrMsg: "requires --escalation-user-group",
+		},
+		{
+			name:       "Rejects an escalation without a wait time",
+			flags:      map[string]string{"escalation-user-group": "123"},
+			wantErr:    true,
+			wantErrMsg: "requires --escalation-wait",
+		},
+		{
+			name:  "Passes along a conflict",
+			flags: map[string]string{},
+			apiCreateFn: func(ctx context.Context, np *api.NotificationProfile) (json.RawMessage, error) {
+				return nil, &api.ConflictError{Message: "a notification profile with that name already exists"}
+			},
+			wantErr:    true,
+			wantErrMsg: "already exists",
+		},
+		{
+			name: "Creates a profile with escalation rules",
+			flags: map[string]string{
+				"rca":                   "true",
+				"downtime-delay":        "2",
+				"persistent-alerts":     "5",
+				"escalation-user-group": "123",
+				"escalation-wait":       "30",
+				"escalation-services":   "456,789",
+			},
+			apiCreateFn: func(ctx context.Context, np *api.NotificationProfile) (json.RawMessage, error) {
+				// return what was sent
+				j, _ := json.Marshal(np)
+
+				return j, nil
+			},
+			want: &api.NotificationProfile{
+				Name:                      "Test Profile",
+				RCANeeded:                 true,
+				DowntimeNotificationDelay: 2,
+				PersistentNotification:    5,
+				EscalationUserGroup:       "123",
+				EscalationWaitTime:        30,
+				EscalationServices:        []string{"456", "789"},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiNotificationProfileCreate = tt.apiCreateFn
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			for k, v := range tt.flags {
+				fs.Set(k, v)
+			}
+
+			got, err := Create(context.Background(), "Test Profile", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Create() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			want, _ := json.MarshalIndent(tt.want, "", "    ")
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Create() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	mockAPIResponse := []byte(`{"profile_id": "1001001SOS", "profile_name": "Test", "downtime_notification_delay": 2}`)
+	mockJSON, _ := json.MarshalIndent(&api.NotificationProfile{ID: "1001001SOS", Name: "Test", DowntimeNotificationDelay: 2}, "", "    ")
+
+	tests := []struct {
+		name       string
+		apiGetFn   func(ctx context.Context, id string) (json.RawMessage, error)
+		want       []byte
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return nil, &api.NotFoundError{Message: "notification profile not found"}
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "not found",
+		},
+		{
+			name: "Returns formatted json",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockJSON,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiNotificationProfileGet = tt.apiGetFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(context.Background(), "1001001SOS")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Get() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fs := GetWriterFlags()
+	fs.Set("escalation-wait", "60")
+
+	get = func(ctx context.Context, id string) (*api.NotificationProfile, error) {
+		return &api.NotificationProfile{
+			ID:                  id,
+			Name:                "Test",
+			RCANeeded:           true,
+			EscalationUserGroup: "123",
+			EscalationWaitTime:  30,
+		}, nil
+	}
+	apiNotificationProfileUpdate = func(ctx context.Context, np *api.NotificationProfile) (json.RawMessage, error) {
+		j, _ := json.Marshal(np)
+
+		return j, nil
+	}
+
+	want, _ := json.MarshalIndent(&api.NotificationProfile{
+		ID:                  "1001001SOS",
+		Name:                "Test",
+		RCANeeded:           true,
+		EscalationUserGroup: "123",
+		EscalationWaitTime:  60,
+	}, "", "    ")
+
+	got, err := Update(context.Background(), "1001001SOS", fs)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Update() = %v, want %v", string(got), string(want))
+	}
+}
+
+func TestDelete(t *testing.T) {
+	apiNotificationProfileDelete = func(ctx context.Context, id string) error {
+		return errors.New("testing")
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err == nil {
+		t.Errorf("Delete() expected an error")
+	}
+
+	apiNotificationProfileDelete = func(ctx context.Context, id string) error {
+		return nil
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err != nil {
+		t.Errorf("Delete() expected no error, got %v", err)
+	}
+}
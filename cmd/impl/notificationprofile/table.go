@@ -0,0 +1,13 @@
+package notificationprofile
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for notification profiles
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("profile_id")},
+	{Header: "NAME", Value: output.Field("profile_name")},
+	{Header: "DOWNTIME DELAY", Value: output.Field("downtime_notification_delay")},
+	{Header: "PERSISTENT ALERTS", Value: output.Field("persistent_notification")},
+	{Header: "ESCALATE TO", Value: output.Field("escalation_user_group_id")},
+	{Header: "ESCALATE AFTER (MIN)", Value: output.Field("escalation_wait_time")},
+}
@@ -0,0 +1,143 @@
+package outage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/monitorgroup"
+	"sort"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiOutageReportGet = api.OutageReportGet
+var resolveMonitor = monitor.Resolve
+var resolveMonitorGroup = monitorgroup.Resolve
+
+// now returns the current time; aliased for testing
+var now = time.Now
+
+// Row is a single outage of a monitor
+type Row struct {
+	MonitorID string `json:"monitor_id"`
+	Name      string `json:"display_name"`
+	api.Outage
+	Ongoing         bool  `json:"ongoing"`
+	DurationSeconds int64 `json:"duration_seconds"` // to the end of the outage, or to now if it's ongoing
+}
+
+// report fetches the outages over a period of a monitor, a monitor group or,
+// when neither is given, every monitor
+var report = func(ctx context.Context, p api.Period, monitorID string, groupID string) (*api.OutageReport, error) {
+	data, err := apiOutageReportGet(ctx, p, monitorID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	var r api.OutageReport
+	if err = json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("[outage.report] Unable to  parse response data (%s)", err)
+	}
+
+	return &r, nil
+}
+
+// timeline lists every outage in a report in the order that they started
+func timeline(r *api.OutageReport) []Row {
+	rows := []Row{}
+	for _, m := range r.Details {
+		for _, o := range m.Outages {
+			row := Row{MonitorID: m.MonitorID, Name: m.Name, Outage: o, Ongoing: o.EndTime == ""}
+
+			start, err := time.Parse(api.TimeLayout, o.StartTime)
+			if err == nil {
+				end := now()
+				if !row.Ongoing {
+					end, err = time.Parse(api.TimeLayout, o.EndTime)
+				}
+				if err == nil {
+					row.DurationSeconds = int64(end.Sub(start).Seconds())
+				}
+			}
+
+			rows = append(rows, row)
+		}
+	}
+
+	sort.SliceStable(rows, func(i, j int) bool {
+		a, _ := time.Parse(api.TimeLayout, rows[i].StartTime)
+		b, _ := time.Parse(api.TimeLayout, rows[j].StartTime)
+
+		return a.Before(b)
+	})
+
+	return rows
+}
+
+// summarize counts the outages and their total duration, e.g. "3 outages of 2
+// monitors, down for 1h20m0s in total"
+func summarize(rows []Row) string {
+	if len(rows) == 0 {
+		return "No outages"
+	}
+
+	monitors := map[string]bool{}
+	var total int64
+	for _, r := range rows {
+		monitors[r.MonitorID] = true
+		total += r.DurationSeconds
+	}
+
+	outages, of := "outages", "monitors"
+	if len(rows) == 1 {
+		outages = "outage"
+	}
+	if len(monitors) == 1 {
+		of = "monitor"
+	}
+
+	return fmt.Sprintf("%d %s of %d %s, down for %s in total", len(rows), outages, len(monitors), of, time.Duration(total)*time.Second)
+}
+
+// List is the implementation of the `outages` command. It returns the outages
+// over the period given by the command's flags, and a summary of them.
+func List(ctx context.Context, fs *pflag.FlagSet) ([]byte, string, error) {
+	p, err := impl.ParsePeriod(fs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	monitorRef, _ := fs.GetString("monitor")
+	groupRef, _ := fs.GetString("group")
+	if monitorRef != "" && groupRef != "" {
+		return nil, "", fmt.Errorf("--monitor can't be combined with --group")
+	}
+
+	var monitorID, groupID string
+	if monitorRef != "" {
+		if monitorID, err = resolveMonitor(ctx, monitorRef); err != nil {
+			return nil, "", err
+		}
+	}
+	if groupRef != "" {
+		if groupID, err = resolveMonitorGroup(ctx, groupRef); err != nil {
+			return nil, "", err
+		}
+	}
+
+	r, err := report(ctx, p, monitorID, groupID)
+	if err != nil {
+		return nil, "", err
+	}
+	rows := timeline(r)
+
+	j, _ := json.MarshalIndent(rows, "", "    ")
+
+	return j, summarize(rows), nil
+}
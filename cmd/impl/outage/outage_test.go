@@ -0,0 +1,130 @@
+package outage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func getFlags(args ...string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+	fs.String("monitor", "", "")
+	fs.String("group", "", "")
+	fs.String("period", "last-7-days", "")
+	fs.String("since", "", "")
+	fs.String("until", "", "")
+	fs.Parse(args)
+
+	return fs
+}
+
+func TestList(t *testing.T) {
+	now = func() time.Time { return time.Date(2021, 6, 16, 12, 0, 0, 0, time.UTC) }
+	t.Cleanup(func() { now = time.Now })
+
+	var gotMonitor, gotGroup string
+	apiOutageReportGet = func(ctx context.Context, p api.Period, monitorID string, groupID string) (json.RawMessage, error) {
+		gotMonitor, gotGroup = monitorID, groupID
+		return []byte(`{"outage_details": [
+			{"monitor_id": "1", "display_name": "Web", "outages": [
+				{"outage_id": "a", "start_time": "2021-06-15T10:00:00+0000", "end_time": "2021-06-15T10:30:00+0000", "reason": "Connection timed out"},
+				{"outage_id": "c", "start_time": "2021-06-16T11:00:00+0000"}
+			]},
+			{"monitor_id": "2", "display_name": "DNS", "outages": [
+				{"outage_id": "b", "start_time": "2021-06-15T10:10:00+0000", "end_time": "2021-06-15T10:15:00+0000", "reason": "No answer"}
+			]}
+		]}`), nil
+	}
+	resolveMonitor = func(ctx context.Context, ref string) (string, error) {
+		if ref == "Web" {
+			return "1", nil
+		}
+		return "", errors.New("unknown monitor")
+	}
+	resolveMonitorGroup = func(ctx context.Context, ref string) (string, error) {
+		return "10", nil
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		wantIDs     []string
+		wantSeconds []int64
+		wantSummary string
+		wantMonitor string
+		wantGroup   string
+		wantErr     bool
+	}{
+		{
+			name:        "Lists outages in the order they started",
+			wantIDs:     []string{"a", "b", "c"},
+			wantSeconds: []int64{1800, 300, 3600},
+			wantSummary: "3 outages of 2 monitors, down for 1h35m0s in total",
+		},
+		{
+			name:        "Lists the outages of a monitor",
+			args:        []string{"--monitor", "Web"},
+			wantIDs:     []string{"a", "b", "c"},
+			wantSeconds: []int64{1800, 300, 3600},
+			wantSummary: "3 outages of 2 monitors, down for 1h35m0s in total",
+			wantMonitor: "1",
+		},
+		{
+			name:        "Lists the outages of a monitor group",
+			args:        []string{"--group", "Production"},
+			wantIDs:     []string{"a", "b", "c"},
+			wantSeconds: []int64{1800, 300, 3600},
+			wantSummary: "3 outages of 2 monitors, down for 1h35m0s in total",
+			wantGroup:   "10",
+		},
+		{
+			name:    "Rejects a monitor and a group",
+			args:    []string{"--monitor", "Web", "--group", "Production"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an unknown monitor",
+			args:    []string{"--monitor", "Mail"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMonitor, gotGroup = "", ""
+			got, summary, err := List(context.Background(), getFlags(tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("List() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var rows []Row
+			json.Unmarshal(got, &rows)
+			var ids []string
+			var seconds []int64
+			for _, r := range rows {
+				ids = append(ids, r.ID)
+				seconds = append(seconds, r.DurationSeconds)
+			}
+			if !reflect.DeepEqual(ids, tt.wantIDs) || !reflect.DeepEqual(seconds, tt.wantSeconds) {
+				t.Errorf("List() = %v lasting %v, want %v lasting %v", ids, seconds, tt.wantIDs, tt.wantSeconds)
+			}
+			if !rows[2].Ongoing || rows[0].Ongoing {
+				t.Errorf("List() = %+v, want only the last outage ongoing", rows)
+			}
+			if summary != tt.wantSummary {
+				t.Errorf("List() summary = %q, want %q", summary, tt.wantSummary)
+			}
+			if gotMonitor != tt.wantMonitor || gotGroup != tt.wantGroup {
+				t.Errorf("List() requested monitor %q, group %q, want %q, %q", gotMonitor, gotGroup, tt.wantMonitor, tt.wantGroup)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package outage
+
+import (
+	"site24x7/cmd/impl/output"
+	"strconv"
+	"time"
+)
+
+// Table defines the default columns displayed for outages
+var Table = output.Table{
+	{Header: "STARTED", Value: output.Field("start_time")},
+	{Header: "ENDED", Value: ended},
+	{Header: "DURATION", Value: duration},
+	{Header: "MONITOR", Value: output.Field("display_name")},
+	{Header: "REASON", Value: output.Field("reason")},
+}
+
+// ended displays when an outage ended, if it has
+func ended(row map[string]interface{}) string {
+	if output.Field("ongoing")(row) == "true" {
+		return "ongoing"
+	}
+
+	return output.Field("end_time")(row)
+}
+
+// duration displays the length of an outage, e.g. 1h5m0s
+func duration(row map[string]interface{}) string {
+	s, err := strconv.ParseInt(output.Field("duration_seconds")(row), 10, 64)
+	if err != nil {
+		return output.Field("duration")(row)
+	}
+
+	return (time.Duration(s) * time.Second).String()
+}
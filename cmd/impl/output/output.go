@@ -0,0 +1,397 @@
+// The output/ package renders command results in the format requested by the
+// global --output flag. Results arrive as the json that the Site24x7 API (and
+// each command implementation) deals in, so every format is derived from that.
+
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"site24x7/logger"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v2"
+)
+
+// Formats lists the supported output formats; jsonpath and go-template take an
+// expression, e.g. jsonpath={.email_address}
+var Formats = []string{"table", "json", "yaml", "csv", "jsonpath=<expr>", "go-template=<template>"}
+
+// DefaultFormat is the format used when none is requested
+const DefaultFormat = "table"
+
+// Column describes a single column of table or csv output
+type Column struct {
+	Header string
+	Value  func(row map[string]interface{}) string
+}
+
+// Table describes the default columns displayed for a resource type
+type Table []Column
+
+// Render writes json data in the format requested by the --output flag
+func Render(fs *pflag.FlagSet, data []byte, t Table) error {
+	format, _ := fs.GetString("output")
+
+	out, err := Format(format, data, t)
+	if err != nil {
+		return err
+	}
+
+	logger.Out(strings.TrimRight(out, "\n"))
+
+	return nil
+}
+
+// Color reports whether output may be colorized; only when it's displayed in a
+// terminal, and never when NO_COLOR is set (https://no-color.org)
+func Color() bool {
+	if _, ok := os.LookupEnv("NO_COLOR"); ok {
+		return false
+	}
+	fi, err := os.Stdout.Stat()
+
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// Format converts json data into a given output format
+func Format(format string, data []byte, t Table) (string, error) {
+	if format == "" {
+		format = DefaultFormat
+	}
+	name, arg, _ := strings.Cut(format, "=")
+
+	// Keep the json exactly as it came to us
+	if name == "json" {
+		return string(data), nil
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", fmt.Errorf("[output.Format] Unable to parse data (%s)", err)
+	}
+
+	switch name {
+	case "table":
+		return toTable(v, t), nil
+	case "csv":
+		return toCSV(v, t)
+	case "yaml":
+		b, err := yaml.Marshal(v)
+		if err != nil {
+			return "", fmt.Errorf("[output.Format] Unable to format yaml (%s)", err)
+		}
+
+		return string(b), nil
+	case "jsonpath":
+		return toJSONPath(v, arg)
+	case "go-template":
+		return toTemplate(v, arg)
+	}
+
+	return "", fmt.Errorf("unsupported output format (%s); expected one of %s", format, strings.Join(Formats, ", "))
+}
+
+// Field returns a column value function that formats the value at a dotted
+// path, e.g. "alert_settings.email_format"
+func Field(path string) func(map[string]interface{}) string {
+	return func(row map[string]interface{}) string {
+		return scalar(lookup(row, path))
+	}
+}
+
+// Count returns a column value function that counts the items at a path
+func Count(path string) func(map[string]interface{}) string {
+	return func(row map[string]interface{}) string {
+		items, _ := lookup(row, path).([]interface{})
+
+		return strconv.Itoa(len(items))
+	}
+}
+
+// Lookup returns a column value function that translates the identifier(s) at
+// a path into friendly names, e.g. a user role of 3 into "Operator"
+func Lookup(path string, names map[int]string) func(map[string]interface{}) string {
+	return func(row map[string]interface{}) string {
+		v := lookup(row, path)
+
+		ids, ok := v.([]interface{})
+		if !ok {
+			ids = []interface{}{v}
+		}
+
+		var out []string
+		for _, id := range ids {
+			f, ok := id.(float64)
+			if !ok {
+				continue
+			}
+			if name, ok := names[int(f)]; ok {
+				out = append(out, name)
+			} else {
+				out = append(out, scalar(f))
+			}
+		}
+
+		return strings.Join(out, ",")
+	}
+}
+
+// rows normalizes data into a list of objects
+func rows(v interface{}) []map[string]interface{} {
+	var list []interface{}
+	switch t := v.(type) {
+	case []interface{}:
+		list = t
+	default:
+		list = []interface{}{t}
+	}
+
+	var out []map[string]interface{}
+	for _, item := range list {
+		if m, ok := item.(map[string]interface{}); ok {
+			out = append(out, m)
+		}
+	}
+
+	return out
+}
+
+// columns returns the table columns; without a table definition every top
+// level key is used
+func columns(v interface{}, t Table) Table {
+	if len(t) > 0 {
+		return t
+	}
+
+	keys := map[string]bool{}
+	for _, r := range rows(v) {
+		for k := range r {
+			keys[k] = true
+		}
+	}
+
+	var names []string
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	var cols Table
+	for _, k := range names {
+		cols = append(cols, Column{strings.ToUpper(k), Field(k)})
+	}
+
+	return cols
+}
+
+// toTable formats data as aligned columns
+func toTable(v interface{}, t Table) string {
+	cols := columns(v, t)
+
+	var b bytes.Buffer
+	w := tabwriter.NewWriter(&b, 0, 4, 3, ' ', 0)
+
+	var headers []string
+	for _, c := range cols {
+		headers = append(headers, c.Header)
+	}
+	fmt.Fprintln(w, strings.Join(headers, "\t"))
+
+	for _, r := range rows(v) {
+		var values []string
+		for _, c := range cols {
+			values = append(values, c.Value(r))
+		}
+		fmt.Fprintln(w, strings.Join(values, "\t"))
+	}
+	w.Flush()
+
+	return b.String()
+}
+
+// toCSV formats data as comma separated values with a header row
+func toCSV(v interface{}, t Table) (string, error) {
+	cols := columns(v, t)
+
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+
+	var headers []string
+	for _, c := range cols {
+		headers = append(headers, c.Header)
+	}
+	w.Write(headers)
+
+	for _, r := range rows(v) {
+		var values []string
+		for _, c := range cols {
+			values = append(values, c.Value(r))
+		}
+		w.Write(values)
+	}
+	w.Flush()
+
+	if err := w.Error(); err != nil {
+		return "", fmt.Errorf("[output.toCSV] Unable to format csv (%s)", err)
+	}
+
+	return b.String(), nil
+}
+
+// toTemplate executes a go template against the data
+func toTemplate(v interface{}, tmpl string) (string, error) {
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return "", fmt.Errorf("invalid go-template (%s)", err)
+	}
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, v); err != nil {
+		return "", fmt.Errorf("unable to execute go-template (%s)", err)
+	}
+
+	return b.String(), nil
+}
+
+// toJSONPath evaluates a jsonpath expression against the data and writes each
+// match on its own line. A subset of jsonpath is supported: child fields
+// (.name), indexes ([0]) and wildcards ([*]). A field applied to a list is
+// applied to each of its items, so {.email_address} works against both a
+// single user and a list of them.
+func toJSONPath(v interface{}, expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	expr = strings.TrimSuffix(strings.TrimPrefix(expr, "{"), "}")
+	expr = strings.TrimPrefix(expr, "$")
+
+	nodes := []interface{}{v}
+	for expr != "" {
+		var next []interface{}
+
+		switch expr[0] {
+		case '.':
+			end := strings.IndexAny(expr[1:], ".[")
+			if end == -1 {
+				end = len(expr) - 1
+			}
+			name := expr[1 : end+1]
+			expr = expr[end+1:]
+			if name == "" {
+				return "", fmt.Errorf("invalid jsonpath; empty field name")
+			}
+
+			for _, n := range flatten(nodes) {
+				if m, ok := n.(map[string]interface{}); ok {
+					if c, ok := m[name]; ok {
+						next = append(next, c)
+					}
+				}
+			}
+		case '[':
+			end := strings.Index(expr, "]")
+			if end == -1 {
+				return "", fmt.Errorf("invalid jsonpath; unterminated [")
+			}
+			index := expr[1:end]
+			expr = expr[end+1:]
+
+			for _, n := range nodes {
+				list, ok := n.([]interface{})
+				if !ok {
+					continue
+				}
+				if index == "*" {
+					next = append(next, list...)
+					continue
+				}
+				i, err := strconv.Atoi(index)
+				if err != nil {
+					return "", fmt.Errorf("invalid jsonpath index (%s)", index)
+				}
+				if i < 0 {
+					i += len(list)
+				}
+				if i >= 0 && i < len(list) {
+					next = append(next, list[i])
+				}
+			}
+		default:
+			return "", fmt.Errorf("invalid jsonpath near %q", expr)
+		}
+
+		nodes = next
+	}
+
+	var lines []string
+	for _, n := range nodes {
+		switch n.(type) {
+		case map[string]interface{}, []interface{}:
+			b, _ := json.Marshal(n)
+			lines = append(lines, string(b))
+		default:
+			lines = append(lines, scalar(n))
+		}
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+// flatten replaces any lists among the nodes with their items
+func flatten(nodes []interface{}) []interface{} {
+	var out []interface{}
+	for _, n := range nodes {
+		if list, ok := n.([]interface{}); ok {
+			out = append(out, list...)
+		} else {
+			out = append(out, n)
+		}
+	}
+
+	return out
+}
+
+// lookup returns the value at a dotted path
+func lookup(row map[string]interface{}, path string) interface{} {
+	var v interface{} = row
+	for _, key := range strings.Split(path, ".") {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[key]
+	}
+
+	return v
+}
+
+// scalar formats a single json value for display
+func scalar(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case []interface{}:
+		var items []string
+		for _, i := range t {
+			items = append(items, scalar(i))
+		}
+
+		return strings.Join(items, ",")
+	default:
+		b, _ := json.Marshal(t)
+		return string(b)
+	}
+}
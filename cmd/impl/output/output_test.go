@@ -0,0 +1,109 @@
+package output
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	type args struct {
+		format string
+		data   []byte
+		t      Table
+	}
+
+	mockData := []byte(`[
+		{"user_id": "1", "display_name": "Fred", "user_role": 3, "notify_medium": [1, 2], "user_groups": ["a", "b"]},
+		{"user_id": "2", "display_name": "Wilma", "user_role": 99, "notify_medium": [], "user_groups": []}
+	]`)
+	mockTable := Table{
+		{Header: "ID", Value: Field("user_id")},
+		{Header: "NAME", Value: Field("display_name")},
+		{Header: "ROLE", Value: Lookup("user_role", map[int]string{3: "Operator"})},
+		{Header: "NOTIFY BY", Value: Lookup("notify_medium", map[int]string{1: "Email", 2: "SMS"})},
+		{Header: "GROUPS", Value: Count("user_groups")},
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		want       string
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Returns json untouched",
+			args: args{format: "json", data: []byte(`{"a": 1}`)},
+			want: `{"a": 1}`,
+		},
+		{
+			name: "Formats a table with friendly names",
+			args: args{format: "table", data: mockData, t: mockTable},
+			want: "ID   NAME    ROLE       NOTIFY BY   GROUPS\n" +
+				"1    Fred    Operator   Email,SMS   2\n" +
+				"2    Wilma   99                     0\n",
+		},
+		{
+			name: "Defaults to a table",
+			args: args{format: "", data: []byte(`{"b": "x", "a": 1}`)},
+			want: "A   B\n1   x\n",
+		},
+		{
+			name: "Formats csv",
+			args: args{format: "csv", data: mockData, t: mockTable},
+			want: "ID,NAME,ROLE,NOTIFY BY,GROUPS\n1,Fred,Operator,\"Email,SMS\",2\n2,Wilma,99,,0\n",
+		},
+		{
+			name: "Formats yaml",
+			args: args{format: "yaml", data: []byte(`{"display_name": "Fred", "user_role": 3}`)},
+			want: "display_name: Fred\nuser_role: 3\n",
+		},
+		{
+			name: "Evaluates a jsonpath field against a list",
+			args: args{format: "jsonpath={.display_name}", data: mockData},
+			want: "Fred\nWilma",
+		},
+		{
+			name: "Evaluates a jsonpath index",
+			args: args{format: "jsonpath={$[1].user_groups}", data: mockData},
+			want: "[]",
+		},
+		{
+			name: "Evaluates a jsonpath wildcard",
+			args: args{format: "jsonpath={[*].notify_medium[0]}", data: mockData},
+			want: "1",
+		},
+		{
+			name: "Executes a go template",
+			args: args{format: "go-template={{range .}}{{.display_name}};{{end}}", data: mockData},
+			want: "Fred;Wilma;",
+		},
+		{
+			name:       "Rejects an unknown format",
+			args:       args{format: "xml", data: mockData},
+			wantErr:    true,
+			wantErrMsg: "unsupported output format (xml)",
+		},
+		{
+			name:       "Rejects an invalid template",
+			args:       args{format: "go-template={{.", data: mockData},
+			wantErr:    true,
+			wantErrMsg: "invalid go-template",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.args.format, tt.args.data, tt.args.t)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Format() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Format() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if got != tt.want {
+				t.Errorf("Format() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package impl
+
+import (
+	"fmt"
+	"site24x7/api"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Periods maps the names accepted by --period to Site24x7 report periods
+// https://www.site24x7.com/help/api/#time_period_constants
+var Periods = map[string]int{
+	"last-hour":     0,
+	"last-24-hours": 1,
+	"last-7-days":   2,
+	"last-30-days":  3,
+	"today":         5,
+	"yesterday":     6,
+	"this-week":     7,
+	"last-week":     8,
+	"this-month":    9,
+	"last-month":    10,
+}
+
+// now returns the current time; aliased for testing
+var now = time.Now
+
+// momentLayouts are the layouts accepted by --since and --until, besides
+// RFC3339 and durations; they're read in the system time zone
+var momentLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+}
+
+// GetPeriodFlags returns the flags that select the span of time covered by a
+// report or history
+func GetPeriodFlags(defaultPeriod string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("period", pflag.ContinueOnError)
+	fs.String("period", defaultPeriod, fmt.Sprintf("Span of time to cover: %s", strings.Join(periodNames(), ", ")))
+	fs.String("since", "", "Start of a custom span of time, as a time, e.g. 2021-06-01 09:00, or a duration ago, e.g. 6h or 3d")
+	fs.String("until", "", "End of a custom span of time, in the same forms as --since (default now)")
+
+	return fs
+}
+
+// periodNames lists the names accepted by --period, in order
+func periodNames() []string {
+	names := make([]string, 0, len(Periods))
+	for n := range Periods {
+		names = append(names, n)
+	}
+	sort.Slice(names, func(i, j int) bool { return Periods[names[i]] < Periods[names[j]] })
+
+	return names
+}
+
+// ParsePeriod reads the --period, --since and --until flags. Giving --since
+// selects a custom span, which --period can't also be given for. The start and
+// end of the span are filled in for every period.
+func ParsePeriod(fs *pflag.FlagSet) (api.Period, error) {
+	t := now()
+	since, _ := fs.GetString("since")
+	until, _ := fs.GetString("until")
+
+	if since == "" {
+		if until != "" {
+			return api.Period{}, fmt.Errorf("--until requires --since")
+		}
+
+		name, _ := fs.GetString("period")
+		code, ok := Periods[strings.ToLower(name)]
+		if !ok {
+			return api.Period{}, fmt.Errorf("unknown period (%s); expected one of %s", name, strings.Join(periodNames(), ", "))
+		}
+		start, end := span(code, t)
+
+		return api.Period{Code: code, Start: start, End: end}, nil
+	}
+
+	if f := fs.Lookup("period"); f != nil && f.Changed {
+		return api.Period{}, fmt.Errorf("--period can't be combined with --since")
+	}
+
+	p := api.Period{Code: api.PeriodCustom, End: t}
+	var err error
+	if p.Start, err = parseMoment(since, t); err != nil {
+		return api.Period{}, err
+	}
+	if until != "" {
+		if p.End, err = parseMoment(until, t); err != nil {
+			return api.Period{}, err
+		}
+	}
+	if !p.Start.Before(p.End) {
+		return api.Period{}, fmt.Errorf("--since (%s) must be before --until (%s)", p.Start.Format(time.RFC3339), p.End.Format(time.RFC3339))
+	}
+
+	return p, nil
+}
+
+// span returns the start and end of a predefined period as of a time; weeks
+// start on Sunday
+func span(code int, t time.Time) (time.Time, time.Time) {
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	week := midnight.AddDate(0, 0, -int(midnight.Weekday()))
+	month := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+
+	switch code {
+	case 0:
+		return t.Add(-time.Hour), t
+	case 1:
+		return t.Add(-24 * time.Hour), t
+	case 2:
+		return t.AddDate(0, 0, -7), t
+	case 3:
+		return t.AddDate(0, 0, -30), t
+	case 5:
+		return midnight, t
+	case 6:
+		return midnight.AddDate(0, 0, -1), midnight
+	case 7:
+		return week, t
+	case 8:
+		return week.AddDate(0, 0, -7), week
+	case 9:
+		return month, t
+	case 10:
+		return month.AddDate(0, -1, 0), month
+	}
+
+	return t, t
+}
+
+// ParseTime reads a time in any of the forms accepted by --since, e.g. a time
+// or a duration ago
+func ParseTime(v string) (time.Time, error) {
+	return parseMoment(v, now())
+}
+
+// parseMoment reads a time in RFC3339 or one of the local layouts, or a
+// duration before a time, e.g. 90m, 6h or 3d
+func parseMoment(v string, t time.Time) (time.Time, error) {
+	if strings.HasSuffix(v, "d") {
+		if n, err := strconv.Atoi(strings.TrimSuffix(v, "d")); err == nil && n >= 0 {
+			return t.AddDate(0, 0, -n), nil
+		}
+	}
+	if d, err := time.ParseDuration(v); err == nil {
+		return t.Add(-d), nil
+	}
+	if m, err := time.Parse(time.RFC3339, v); err == nil {
+		return m, nil
+	}
+	for _, layout := range momentLayouts {
+		if m, err := time.ParseInLocation(layout, v, t.Location()); err == nil {
+			return m, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid time (%s); expected a duration ago, e.g. 6h or 3d, RFC3339, e.g. 2021-06-01T22:00:00Z, or local time, e.g. 2021-06-01 22:00", v)
+}
@@ -0,0 +1,86 @@
+package impl
+
+import (
+	"site24x7/api"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+func TestParsePeriod(t *testing.T) {
+	// A Wednesday
+	at := time.Date(2021, 6, 16, 15, 30, 0, 0, time.UTC)
+	now = func() time.Time { return at }
+	t.Cleanup(func() { now = time.Now })
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    api.Period
+		wantErr bool
+	}{
+		{
+			name: "Defaults to the default period",
+			want: api.Period{Code: 1, Start: at.Add(-24 * time.Hour), End: at},
+		},
+		{
+			name: "Spans a predefined period",
+			args: []string{"--period", "last-week"},
+			want: api.Period{Code: 8, Start: time.Date(2021, 6, 6, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 6, 13, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			name: "Spans a custom period by duration",
+			args: []string{"--since", "3d", "--until", "90m"},
+			want: api.Period{Code: api.PeriodCustom, Start: at.AddDate(0, 0, -3), End: at.Add(-90 * time.Minute)},
+		},
+		{
+			name: "Spans a custom period by time",
+			args: []string{"--since", "2021-06-01", "--until", "2021-06-02T12:00:00Z"},
+			want: api.Period{Code: api.PeriodCustom, Start: time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC), End: time.Date(2021, 6, 2, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			name:    "Rejects an unknown period",
+			args:    []string{"--period", "fortnight"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects a period with --since",
+			args:    []string{"--period", "today", "--since", "1h"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects --until without --since",
+			args:    []string{"--until", "1h"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects a span that ends before it starts",
+			args:    []string{"--since", "1h", "--until", "2h"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an invalid time",
+			args:    []string{"--since", "last tuesday"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+			fs.AddFlagSet(GetPeriodFlags("last-24-hours"))
+			fs.Parse(tt.args)
+
+			got, err := ParsePeriod(fs)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePeriod() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got.Code != tt.want.Code || !got.Start.Equal(tt.want.Start) || !got.End.Equal(tt.want.End) {
+				t.Errorf("ParsePeriod() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
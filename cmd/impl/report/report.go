@@ -0,0 +1,215 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/logger"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiAvailabilitySummaryGet = api.AvailabilitySummaryGet
+var apiPerformanceReportGet = api.PerformanceReportGet
+var apiMonitorGroupGet = api.MonitorGroupGet
+var resolveMonitor = monitor.Resolve
+var resolveMonitorGroup = monitorgroup.Resolve
+
+// Span is the span of time that a row of a report covers, so that rows still
+// make sense once they're pasted into a spreadsheet
+type Span struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// AvailabilityRow is a monitor's availability over a period
+type AvailabilityRow struct {
+	MonitorID string `json:"monitor_id"`
+	Name      string `json:"display_name"`
+	Span
+	api.AvailabilitySummary
+}
+
+// PerformanceRow is a monitor's performance over a period
+type PerformanceRow struct {
+	MonitorID string `json:"monitor_id"`
+	Name      string `json:"display_name"`
+	Span
+	api.PerformanceSummary
+}
+
+// SLARow reports whether a monitor's availability met a target over a period
+type SLARow struct {
+	MonitorID string `json:"monitor_id"`
+	Name      string `json:"display_name"`
+	Span
+	Target                 float64 `json:"target_percentage"`
+	AvailabilityPercentage float64 `json:"availability_percentage"`
+	Met                    bool    `json:"met"`
+	AllowedDowntimeSeconds int64   `json:"allowed_downtime_seconds"`
+	DowntimeSeconds        int64   `json:"downtime_seconds"`
+}
+
+// monitors returns the IDs of the monitors given by the --monitor or --group
+// flag; a report covers one or the other
+func monitors(ctx context.Context, fs *pflag.FlagSet) ([]string, error) {
+	monitorRef, _ := fs.GetString("monitor")
+	groupRef, _ := fs.GetString("group")
+
+	switch {
+	case monitorRef != "" && groupRef != "":
+		return nil, fmt.Errorf("--monitor can't be combined with --group")
+	case monitorRef != "":
+		id, err := resolveMonitor(ctx, monitorRef)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{id}, nil
+	case groupRef != "":
+		id, err := resolveMonitorGroup(ctx, groupRef)
+		if err != nil {
+			return nil, err
+		}
+		data, err := apiMonitorGroupGet(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		var mg api.MonitorGroup
+		if err := json.Unmarshal(data, &mg); err != nil {
+			return nil, fmt.Errorf("[report.monitors] Unable to  parse response data (%s)", err)
+		}
+		if len(mg.Monitors) == 0 {
+			return nil, fmt.Errorf("monitor group (%s) has no monitors", groupRef)
+		}
+
+		return mg.Monitors, nil
+	}
+
+	return nil, fmt.Errorf("a report requires either --monitor or --group")
+}
+
+// span returns the span of a period, as it's reported
+func span(p api.Period) Span {
+	return Span{From: p.Start.Format(time.RFC3339), To: p.End.Format(time.RFC3339)}
+}
+
+// availability fetches the availability of each monitor given by the
+// command's flags over the period they give
+func availability(ctx context.Context, fs *pflag.FlagSet) ([]AvailabilityRow, api.Period, error) {
+	p, err := impl.ParsePeriod(fs)
+	if err != nil {
+		return nil, p, err
+	}
+	ids, err := monitors(ctx, fs)
+	if err != nil {
+		return nil, p, err
+	}
+
+	rows := []AvailabilityRow{}
+	for _, id := range ids {
+		logger.Info(fmt.Sprintf("[report.availability] Fetching the availability of monitor %s", id))
+		data, err := apiAvailabilitySummaryGet(ctx, id, p)
+		if err != nil {
+			return nil, p, err
+		}
+
+		var r api.AvailabilityReport
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, p, fmt.Errorf("[report.availability] Unable to  parse response data (%s)", err)
+		}
+		rows = append(rows, AvailabilityRow{MonitorID: id, Name: r.Info.ResourceName, Span: span(p), AvailabilitySummary: r.Summary})
+	}
+
+	return rows, p, nil
+}
+
+// Availability is the implementation of the `report availability` command
+func Availability(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
+	rows, _, err := availability(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(rows, "", "    ")
+
+	return j, nil
+}
+
+// Performance is the implementation of the `report performance` command
+func Performance(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
+	p, err := impl.ParsePeriod(fs)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := monitors(ctx, fs)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := []PerformanceRow{}
+	for _, id := range ids {
+		logger.Info(fmt.Sprintf("[report.Performance] Fetching the performance of monitor %s", id))
+		data, err := apiPerformanceReportGet(ctx, id, p)
+		if err != nil {
+			return nil, err
+		}
+
+		var r api.PerformanceReport
+		if err := json.Unmarshal(data, &r); err != nil {
+			return nil, fmt.Errorf("[report.Performance] Unable to  parse response data (%s)", err)
+		}
+		rows = append(rows, PerformanceRow{MonitorID: id, Name: r.Info.ResourceName, Span: span(p), PerformanceSummary: r.Summary})
+	}
+
+	j, _ := json.MarshalIndent(rows, "", "    ")
+
+	return j, nil
+}
+
+// SLA is the implementation of the `report sla` command. It compares each
+// monitor's availability with the --target percentage and returns the result
+// and a summary of it.
+func SLA(ctx context.Context, fs *pflag.FlagSet) ([]byte, string, error) {
+	target, _ := fs.GetFloat64("target")
+	if target <= 0 || target > 100 {
+		return nil, "", fmt.Errorf("--target must be a percentage greater than 0, e.g. 99.9")
+	}
+
+	availabilities, p, err := availability(ctx, fs)
+	if err != nil {
+		return nil, "", err
+	}
+
+	length := p.End.Sub(p.Start).Seconds()
+	rows := []SLARow{}
+	var met int
+	for _, a := range availabilities {
+		r := SLARow{
+			MonitorID:              a.MonitorID,
+			Name:                   a.Name,
+			Span:                   a.Span,
+			Target:                 target,
+			AvailabilityPercentage: a.AvailabilityPercentage,
+			Met:                    a.AvailabilityPercentage >= target,
+			AllowedDowntimeSeconds: int64(length * (100 - target) / 100),
+			DowntimeSeconds:        int64(length * a.DowntimePercentage / 100),
+		}
+		if r.Met {
+			met++
+		}
+		rows = append(rows, r)
+	}
+
+	j, _ := json.MarshalIndent(rows, "", "    ")
+	summary := fmt.Sprintf("%d of %d monitors met the %g%% target", met, len(rows), target)
+
+	return j, summary, nil
+}
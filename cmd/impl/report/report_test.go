@@ -0,0 +1,160 @@
+package report
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"site24x7/api"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func getFlags(args ...string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+	fs.String("monitor", "", "")
+	fs.String("group", "", "")
+	fs.String("period", "last-30-days", "")
+	fs.String("since", "", "")
+	fs.String("until", "", "")
+	fs.Float64("target", 99.9, "")
+	fs.Parse(args)
+
+	return fs
+}
+
+func setup() {
+	apiMonitorGroupGet = func(ctx context.Context, id string) (json.RawMessage, error) {
+		if id == "20" {
+			return []byte(`{"group_id": "20", "monitors": []}`), nil
+		}
+		return []byte(`{"group_id": "10", "monitors": ["1", "2"]}`), nil
+	}
+	resolveMonitor = func(ctx context.Context, ref string) (string, error) {
+		if ref == "Web" {
+			return "1", nil
+		}
+		return "", errors.New("unknown monitor")
+	}
+	resolveMonitorGroup = func(ctx context.Context, ref string) (string, error) {
+		return map[string]string{"Production": "10", "Empty": "20"}[ref], nil
+	}
+	apiAvailabilitySummaryGet = func(ctx context.Context, id string, p api.Period) (json.RawMessage, error) {
+		availability := map[string]float64{"1": 100, "2": 99.5}[id]
+		return []byte(fmt.Sprintf(`{"info": {"resource_name": "Monitor %s"}, "summary_details": {"availability_percentage": %g, "downtime_percentage": %g, "down_count": 1}}`, id, availability, 100-availability)), nil
+	}
+	apiPerformanceReportGet = func(ctx context.Context, id string, p api.Period) (json.RawMessage, error) {
+		return []byte(fmt.Sprintf(`{"info": {"resource_name": "Monitor %s"}, "summary_details": {"average_response_time": 120, "unit": "ms"}}`, id)), nil
+	}
+}
+
+func TestAvailability(t *testing.T) {
+	setup()
+
+	tests := []struct {
+		name    string
+		args    []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "Reports on a monitor",
+			args: []string{"--monitor", "Web"},
+			want: []string{"Monitor 1"},
+		},
+		{
+			name: "Reports on each monitor in a group",
+			args: []string{"--group", "Production"},
+			want: []string{"Monitor 1", "Monitor 2"},
+		},
+		{
+			name:    "Requires a monitor or a group",
+			wantErr: true,
+		},
+		{
+			name:    "Rejects a monitor and a group",
+			args:    []string{"--monitor", "Web", "--group", "Production"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an empty group",
+			args:    []string{"--group", "Empty"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an unknown period",
+			args:    []string{"--monitor", "Web", "--period", "forever"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Availability(context.Background(), getFlags(tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Availability() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var rows []AvailabilityRow
+			json.Unmarshal(got, &rows)
+			var names []string
+			for _, r := range rows {
+				names = append(names, r.Name)
+				if r.From == "" || r.To == "" || r.DownCount != 1 {
+					t.Errorf("Availability() row = %+v, want its span and summary", r)
+				}
+			}
+			if !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Availability() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestPerformance(t *testing.T) {
+	setup()
+
+	got, err := Performance(context.Background(), getFlags("--monitor", "Web"))
+	if err != nil {
+		t.Fatalf("Performance() error = %v", err)
+	}
+
+	var rows []PerformanceRow
+	json.Unmarshal(got, &rows)
+	if len(rows) != 1 || rows[0].MonitorID != "1" || rows[0].AverageResponseTime != 120 || rows[0].Unit != "ms" {
+		t.Errorf("Performance() = %+v, want monitor 1 averaging 120ms", rows)
+	}
+}
+
+func TestSLA(t *testing.T) {
+	setup()
+
+	got, summary, err := SLA(context.Background(), getFlags("--group", "Production", "--since", "2021-06-01T00:00:00Z", "--until", "2021-06-02T00:00:00Z", "--target", "99.9"))
+	if err != nil {
+		t.Fatalf("SLA() error = %v", err)
+	}
+
+	var rows []SLARow
+	json.Unmarshal(got, &rows)
+	want := []SLARow{
+		{MonitorID: "1", Name: "Monitor 1", Target: 99.9, AvailabilityPercentage: 100, Met: true, AllowedDowntimeSeconds: 86, DowntimeSeconds: 0},
+		{MonitorID: "2", Name: "Monitor 2", Target: 99.9, AvailabilityPercentage: 99.5, Met: false, AllowedDowntimeSeconds: 86, DowntimeSeconds: 432},
+	}
+	for i := range rows {
+		rows[i].Span = Span{}
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("SLA() = %+v, want %+v", rows, want)
+	}
+	if want := "1 of 2 monitors met the 99.9% target"; summary != want {
+		t.Errorf("SLA() summary = %q, want %q", summary, want)
+	}
+
+	if _, _, err := SLA(context.Background(), getFlags("--monitor", "Web", "--target", "0")); err == nil {
+		t.Errorf("SLA() error = nil, want one for a target of 0")
+	}
+}
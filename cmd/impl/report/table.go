@@ -0,0 +1,36 @@
+package report
+
+import "site24x7/cmd/impl/output"
+
+// AvailabilityTable defines the default columns displayed for availability
+// reports
+var AvailabilityTable = output.Table{
+	{Header: "MONITOR", Value: output.Field("display_name")},
+	{Header: "AVAILABILITY %", Value: output.Field("availability_percentage")},
+	{Header: "DOWNTIME %", Value: output.Field("downtime_percentage")},
+	{Header: "MAINTENANCE %", Value: output.Field("maintenance_percentage")},
+	{Header: "OUTAGES", Value: output.Field("down_count")},
+	{Header: "DOWNTIME", Value: output.Field("downtime_duration")},
+	{Header: "MTTR", Value: output.Field("mttr")},
+}
+
+// PerformanceTable defines the default columns displayed for performance
+// reports
+var PerformanceTable = output.Table{
+	{Header: "MONITOR", Value: output.Field("display_name")},
+	{Header: "AVERAGE", Value: output.Field("average_response_time")},
+	{Header: "MINIMUM", Value: output.Field("min_response_time")},
+	{Header: "MAXIMUM", Value: output.Field("max_response_time")},
+	{Header: "95TH PERCENTILE", Value: output.Field("95_percentile_response_time")},
+	{Header: "UNIT", Value: output.Field("unit")},
+}
+
+// SLATable defines the default columns displayed for SLA reports
+var SLATable = output.Table{
+	{Header: "MONITOR", Value: output.Field("display_name")},
+	{Header: "TARGET %", Value: output.Field("target_percentage")},
+	{Header: "AVAILABILITY %", Value: output.Field("availability_percentage")},
+	{Header: "MET", Value: output.Field("met")},
+	{Header: "ALLOWED DOWNTIME (S)", Value: output.Field("allowed_downtime_seconds")},
+	{Header: "DOWNTIME (S)", Value: output.Field("downtime_seconds")},
+}
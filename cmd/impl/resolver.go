@@ -0,0 +1,125 @@
+package impl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Reference identifies an object that may be referred to by its ID or by any
+// of its names, e.g. a user's email address or display name. Names are listed
+// in order of preference; a later name is only considered when no object
+// matches an earlier one.
+type Reference struct {
+	ID    string
+	Names []string
+}
+
+// Resolver translates references to one kind of object, given as either IDs
+// or names, into IDs. Objects are listed at most once, the first time that a
+// reference needs to be resolved, so resolving any number of references costs
+// a command a single request.
+type Resolver struct {
+	Kind    string // e.g. "monitor group"
+	Command string // the command that lists the objects, for help
+	List    func(ctx context.Context) ([]Reference, error)
+
+	refs    []Reference
+	listErr error
+	loaded  bool
+}
+
+// isID reports whether a reference looks like an ID; Site24x7 IDs are numeric,
+// but so are some names, e.g. "2024"
+func isID(ref string) bool {
+	if ref == "" {
+		return false
+	}
+	for _, c := range ref {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// references lists, and caches, the objects that may be referred to. A failure
+// to list them is cached too, so that a command's numeric references don't
+// each retry it.
+func (r *Resolver) references(ctx context.Context) ([]Reference, error) {
+	if !r.loaded {
+		r.refs, r.listErr = r.List(ctx)
+		r.loaded = true
+	}
+
+	return r.refs, r.listErr
+}
+
+// Resolve translates a single reference into an ID. Names are matched without
+// regard to case; a name that matches more than one object is an error. A
+// numeric reference is taken to be an ID unless it's only known as a name, so
+// it's passed through when the objects can't be listed or none matches it.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ref, nil
+	}
+
+	refs, err := r.references(ctx)
+	if err != nil {
+		if isID(ref) {
+			return ref, nil
+		}
+		return "", err
+	}
+
+	for _, o := range refs {
+		if o.ID == ref {
+			return o.ID, nil
+		}
+	}
+
+	for i := 0; ; i++ {
+		var matches []string
+		more := false
+		for _, o := range refs {
+			if i >= len(o.Names) {
+				continue
+			}
+			more = true
+			if strings.EqualFold(o.Names[i], ref) {
+				matches = append(matches, o.ID)
+			}
+		}
+
+		switch {
+		case len(matches) == 1:
+			return matches[0], nil
+		case len(matches) > 1:
+			return "", fmt.Errorf("ambiguous %s (%s) matches IDs %s; use an ID instead", r.Kind, ref, strings.Join(matches, ", "))
+		case !more && isID(ref):
+			return ref, nil
+		case !more:
+			return "", fmt.Errorf("unknown %s (%s); see `site24x7 %s`", r.Kind, ref, r.Command)
+		}
+	}
+}
+
+// ResolveAll translates a list of references into IDs
+func (r *Resolver) ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	if len(refs) == 0 {
+		return refs, nil
+	}
+
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		id, err := r.Resolve(ctx, ref)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+
+	return ids, nil
+}
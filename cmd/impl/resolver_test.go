@@ -0,0 +1,103 @@
+package impl
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResolver_ResolveAll(t *testing.T) {
+	mockRefs := []Reference{
+		{ID: "1", Names: []string{"fred@example.com", "Fred"}},
+		{ID: "2", Names: []string{"wilma@example.com", "Wilma"}},
+		{ID: "3", Names: []string{"wilma@example.org", "Wilma"}},
+		{ID: "abc", Names: []string{"barney@example.com"}},
+		{ID: "4", Names: []string{"2024"}},
+	}
+
+	tests := []struct {
+		name       string
+		refs       []string
+		listFn     func(ctx context.Context) ([]Reference, error)
+		want       []string
+		wantLists  int
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name:      "Passes numeric IDs through when the list fails",
+			refs:      []string{"1", "42"},
+			listFn:    func(ctx context.Context) ([]Reference, error) { return nil, errors.New("testing") },
+			want:      []string{"1", "42"},
+			wantLists: 1,
+		},
+		{
+			name:      "Resolves a numeric name that isn't an ID",
+			refs:      []string{"2024", "2", "42"},
+			listFn:    func(ctx context.Context) ([]Reference, error) { return mockRefs, nil },
+			want:      []string{"4", "2", "42"},
+			wantLists: 1,
+		},
+		{
+			name:       "Handles a list error",
+			refs:       []string{"Fred"},
+			listFn:     func(ctx context.Context) ([]Reference, error) { return nil, errors.New("testing") },
+			wantLists:  1,
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name:      "Resolves names and IDs, listing only once",
+			refs:      []string{"FRED", "wilma@example.com", "abc", "wilma@example.org"},
+			listFn:    func(ctx context.Context) ([]Reference, error) { return mockRefs, nil },
+			want:      []string{"1", "2", "abc", "3"},
+			wantLists: 1,
+		},
+		{
+			name:       "Rejects an ambiguous name",
+			refs:       []string{"Wilma"},
+			listFn:     func(ctx context.Context) ([]Reference, error) { return mockRefs, nil },
+			wantLists:  1,
+			wantErr:    true,
+			wantErrMsg: "ambiguous thing (Wilma) matches IDs 2, 3",
+		},
+		{
+			name:       "Rejects an unknown name",
+			refs:       []string{"Betty"},
+			listFn:     func(ctx context.Context) ([]Reference, error) { return mockRefs, nil },
+			wantLists:  1,
+			wantErr:    true,
+			wantErrMsg: "unknown thing (Betty); see `site24x7 thing list`",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists := 0
+			r := &Resolver{
+				Kind:    "thing",
+				Command: "thing list",
+				List: func(ctx context.Context) ([]Reference, error) {
+					lists++
+					return tt.listFn(ctx)
+				},
+			}
+
+			got, err := r.ResolveAll(context.Background(), tt.refs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Resolver.ResolveAll() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Resolver.ResolveAll() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Resolver.ResolveAll() = %v, want %v", got, tt.want)
+			}
+			if lists != tt.wantLists {
+				t.Errorf("Resolver.ResolveAll() listed %d times, want %d", lists, tt.wantLists)
+			}
+		})
+	}
+}
@@ -0,0 +1,259 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/cmd/impl/tag"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiCurrentStatusGet = api.CurrentStatusGet
+var apiMonitorList = api.MonitorList
+var resolveMonitorGroups = monitorgroup.ResolveAll
+var resolveTags = tag.ResolveAll
+
+// Ungrouped is the group name given to monitors that aren't in any group
+const Ungrouped = "(ungrouped)"
+
+// Row is the status of a monitor within one of its monitor groups; a monitor
+// that's in more than one group has a row for each
+type Row struct {
+	GroupID   string `json:"group_id,omitempty"`
+	GroupName string `json:"group_name"`
+	api.MonitorStatus
+	PreviousStatus *int `json:"previous_status,omitempty"` // only when the status has changed
+}
+
+// current fetches the current status of every monitor
+var current = func(ctx context.Context) (*api.CurrentStatus, error) {
+	data, err := apiCurrentStatusGet(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cs api.CurrentStatus
+	if err = json.Unmarshal(data, &cs); err != nil {
+		return nil, fmt.Errorf("[status.current] Unable to  parse response data (%s)", err)
+	}
+
+	return &cs, nil
+}
+
+// rows lists the status of each monitor by group, with monitors that aren't
+// in a group last
+func rows(cs *api.CurrentStatus) []Row {
+	var out []Row
+	grouped := map[string]bool{}
+	for _, g := range cs.MonitorGroups {
+		for _, m := range g.Monitors {
+			out = append(out, Row{GroupID: g.ID, GroupName: g.Name, MonitorStatus: m})
+			grouped[m.ID] = true
+		}
+	}
+	for _, m := range cs.Monitors {
+		if !grouped[m.ID] {
+			out = append(out, Row{GroupName: Ungrouped, MonitorStatus: m})
+		}
+	}
+
+	return out
+}
+
+// filter narrows the rows to those requested by the command's flags; a nil
+// set matches anything
+type filter struct {
+	groups   map[string]bool // group IDs
+	states   map[int]bool    // status codes
+	monitors map[string]bool // monitor IDs, of those with a given tag
+}
+
+// newFilter reads the --group, --state and --tag flags
+func newFilter(ctx context.Context, fs *pflag.FlagSet) (*filter, error) {
+	f := &filter{}
+
+	if refs, _ := fs.GetStringSlice("group"); len(refs) > 0 {
+		ids, err := resolveMonitorGroups(ctx, refs)
+		if err != nil {
+			return nil, err
+		}
+		f.groups = set(ids)
+	}
+
+	if names, _ := fs.GetStringSlice("state"); len(names) > 0 {
+		states, err := parseStates(names)
+		if err != nil {
+			return nil, err
+		}
+		f.states = states
+	}
+
+	// The current status doesn't include tags, so look them up
+	if tags, _ := fs.GetStringSlice("tag"); len(tags) > 0 {
+		tags, err := resolveTags(ctx, tags)
+		if err != nil {
+			return nil, err
+		}
+		data, err := apiMonitorList(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var monitors []api.Monitor
+		if err := json.Unmarshal(data, &monitors); err != nil {
+			return nil, fmt.Errorf("[status.newFilter] Unable to  parse response data (%s)", err)
+		}
+
+		wanted := set(tags)
+		f.monitors = map[string]bool{}
+		for _, m := range monitors {
+			for _, t := range m.Tags {
+				if wanted[t] {
+					f.monitors[m.ID] = true
+				}
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// match reports whether a row is wanted
+func (f *filter) match(r Row) bool {
+	return (f.groups == nil || f.groups[r.GroupID]) &&
+		(f.states == nil || f.states[r.Status]) &&
+		(f.monitors == nil || f.monitors[r.ID])
+}
+
+// parseStates translates state names, e.g. down or configuration-error, into
+// status codes
+func parseStates(names []string) (map[int]bool, error) {
+	states := map[int]bool{}
+	for _, n := range names {
+		n = strings.ReplaceAll(strings.TrimSpace(n), "-", " ")
+
+		found := false
+		for code, name := range States {
+			if strings.EqualFold(name, n) {
+				states[code], found = true, true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown state (%s); expected one of %s", n, strings.Join(stateNames(), ", "))
+		}
+	}
+
+	return states, nil
+}
+
+// stateNames lists the names accepted by --state
+func stateNames() []string {
+	var names []string
+	for _, code := range stateOrder {
+		names = append(names, strings.ToLower(strings.ReplaceAll(States[code], " ", "-")))
+	}
+
+	return names
+}
+
+// set returns the members of a list as a set
+func set(list []string) map[string]bool {
+	s := map[string]bool{}
+	for _, v := range list {
+		s[v] = true
+	}
+
+	return s
+}
+
+// summarize counts monitors by status, most severe first, e.g. "1 down, 12 up".
+// A monitor in more than one group is only counted once.
+func summarize(rows []Row) string {
+	counts := map[int]int{}
+	seen := map[string]bool{}
+	for _, r := range rows {
+		if !seen[r.ID] {
+			seen[r.ID] = true
+			counts[r.Status]++
+		}
+	}
+
+	var codes []int
+	for code := range counts {
+		codes = append(codes, code)
+	}
+	sort.Slice(codes, func(i, j int) bool { return severity(codes[i]) < severity(codes[j]) })
+
+	var parts []string
+	for _, code := range codes {
+		name, ok := States[code]
+		if !ok {
+			name = fmt.Sprintf("status %d", code)
+		}
+		parts = append(parts, fmt.Sprintf("%d %s", counts[code], strings.ToLower(name)))
+	}
+	if len(parts) == 0 {
+		return "No monitors"
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// severity ranks a status code; unknown codes rank after known ones
+func severity(code int) int {
+	for i, c := range stateOrder {
+		if c == code {
+			return i
+		}
+	}
+
+	return len(stateOrder) + code
+}
+
+// Watcher reports the current status, noting each monitor whose status has
+// changed since the watcher's previous report
+type Watcher struct {
+	previous map[string]int // status by monitor ID, once reported
+}
+
+// Next is the implementation of the `status` command. It returns the status of
+// the monitors that match the command's flags and a summary of them.
+func (w *Watcher) Next(ctx context.Context, fs *pflag.FlagSet) ([]byte, string, error) {
+	f, err := newFilter(ctx, fs)
+	if err != nil {
+		return nil, "", err
+	}
+	cs, err := current(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	statuses := map[string]int{}
+	matched := []Row{}
+	for _, r := range rows(cs) {
+		statuses[r.ID] = r.Status
+		if !f.match(r) {
+			continue
+		}
+		if was, ok := w.previous[r.ID]; ok && was != r.Status {
+			r.PreviousStatus = &was
+		}
+		matched = append(matched, r)
+	}
+	w.previous = statuses
+
+	j, _ := json.MarshalIndent(matched, "", "    ")
+
+	return j, summarize(matched), nil
+}
+
+// Get reports the current status once
+func Get(ctx context.Context, fs *pflag.FlagSet) ([]byte, string, error) {
+	return (&Watcher{}).Next(ctx, fs)
+}
@@ -0,0 +1,174 @@
+package status
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// mockStatus returns a current status in which a monitor is in two groups,
+// and another in none
+func mockStatus(webStatus int) func(ctx context.Context) (json.RawMessage, error) {
+	return func(ctx context.Context) (json.RawMessage, error) {
+		web := map[string]interface{}{"monitor_id": "1", "name": "Web", "monitor_type": "URL", "status": webStatus}
+		cs := map[string]interface{}{
+			"monitor_groups": []interface{}{
+				map[string]interface{}{"group_id": "10", "group_name": "Production", "monitors": []interface{}{
+					web,
+					map[string]interface{}{"monitor_id": "2", "name": "DNS", "monitor_type": "DNS", "status": 2, "down_reason": "Slow"},
+				}},
+				map[string]interface{}{"group_id": "11", "group_name": "Customer-facing", "monitors": []interface{}{web}},
+			},
+			"monitors": []interface{}{
+				web,
+				map[string]interface{}{"monitor_id": "3", "name": "Ping", "monitor_type": "PING", "status": 7},
+			},
+		}
+		b, _ := json.Marshal(cs)
+
+		return b, nil
+	}
+}
+
+func getFlags(args ...string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("testing", pflag.ContinueOnError)
+	fs.StringSlice("group", nil, "")
+	fs.StringSlice("tag", nil, "")
+	fs.StringSlice("state", nil, "")
+	fs.Parse(args)
+
+	return fs
+}
+
+// names returns the group and monitor name of each row
+func names(t *testing.T, data []byte) []string {
+	var rows []Row
+	if err := json.Unmarshal(data, &rows); err != nil {
+		t.Fatalf("Unable to parse %s", data)
+	}
+
+	out := []string{}
+	for _, r := range rows {
+		out = append(out, r.GroupName+"/"+r.Name)
+	}
+
+	return out
+}
+
+func TestGet(t *testing.T) {
+	apiCurrentStatusGet = mockStatus(0)
+	apiMonitorList = func(ctx context.Context) (json.RawMessage, error) {
+		return []byte(`[{"monitor_id": "1", "tag_ids": ["100"]}, {"monitor_id": "3", "tag_ids": ["100", "200"]}]`), nil
+	}
+	resolveMonitorGroups = func(ctx context.Context, refs []string) ([]string, error) {
+		if refs[0] == "Production" {
+			return []string{"10"}, nil
+		}
+		return nil, errors.New("unknown monitor group")
+	}
+
+	tests := []struct {
+		name        string
+		args        []string
+		want        []string
+		wantSummary string
+		wantErr     bool
+	}{
+		{
+			name:        "Lists monitors by group",
+			want:        []string{"Production/Web", "Production/DNS", "Customer-facing/Web", Ungrouped + "/Ping"},
+			wantSummary: "1 down, 1 trouble, 1 maintenance",
+		},
+		{
+			name:        "Filters by group",
+			args:        []string{"--group", "Production"},
+			want:        []string{"Production/Web", "Production/DNS"},
+			wantSummary: "1 down, 1 trouble",
+		},
+		{
+			name:        "Filters by state",
+			args:        []string{"--state", "trouble,Maintenance"},
+			want:        []string{"Production/DNS", Ungrouped + "/Ping"},
+			wantSummary: "1 trouble, 1 maintenance",
+		},
+		{
+			name:        "Filters by tag",
+			args:        []string{"--tag", "200"},
+			want:        []string{Ungrouped + "/Ping"},
+			wantSummary: "1 maintenance",
+		},
+		{
+			name:        "Reports no matches",
+			args:        []string{"--state", "up"},
+			want:        []string{},
+			wantSummary: "No monitors",
+		},
+		{
+			name:    "Rejects an unknown state",
+			args:    []string{"--state", "sideways"},
+			wantErr: true,
+		},
+		{
+			name:    "Rejects an unknown group",
+			args:    []string{"--group", "Staging"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, summary, err := Get(context.Background(), getFlags(tt.args...))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if names := names(t, got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("Get() = %v, want %v", names, tt.want)
+			}
+			if summary != tt.wantSummary {
+				t.Errorf("Get() summary = %q, want %q", summary, tt.wantSummary)
+			}
+		})
+	}
+}
+
+func TestWatcher_Next(t *testing.T) {
+	var w Watcher
+	fs := getFlags()
+
+	apiCurrentStatusGet = mockStatus(1)
+	got, _, err := w.Next(context.Background(), fs)
+	if err != nil {
+		t.Fatalf("Watcher.Next() error = %v", err)
+	}
+	if strings.Contains(string(got), "previous_status") {
+		t.Errorf("Watcher.Next() = %s, want no changes on the first report", got)
+	}
+
+	apiCurrentStatusGet = mockStatus(0)
+	got, _, err = w.Next(context.Background(), fs)
+	if err != nil {
+		t.Fatalf("Watcher.Next() error = %v", err)
+	}
+
+	var rows []Row
+	json.Unmarshal(got, &rows)
+	for _, r := range rows {
+		changed := r.PreviousStatus != nil
+		if changed != (r.ID == "1") || (changed && *r.PreviousStatus != 1) {
+			t.Errorf("Watcher.Next() row %s/%s was %v, want only Web to have changed from up", r.GroupName, r.Name, r.PreviousStatus)
+		}
+	}
+
+	// The change is shown, in color when asked
+	rendered := WatchTable(true)[len(Table)].Value(map[string]interface{}{"status": 0.0, "previous_status": 1.0})
+	if want := colorRed + "Up -> Down" + colorReset; rendered != want {
+		t.Errorf("WatchTable() shows %q, want %q", rendered, want)
+	}
+}
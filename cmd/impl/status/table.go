@@ -0,0 +1,54 @@
+package status
+
+import (
+	"fmt"
+	"site24x7/cmd/impl/output"
+)
+
+// Colors used to highlight a change of status
+const (
+	colorReset  = "\033[0m"
+	colorRed    = "\033[31m"
+	colorGreen  = "\033[32m"
+	colorYellow = "\033[33m"
+)
+
+// Table defines the default columns displayed for monitor statuses
+var Table = output.Table{
+	{Header: "GROUP", Value: output.Field("group_name")},
+	{Header: "MONITOR", Value: output.Field("name")},
+	{Header: "TYPE", Value: output.Field("monitor_type")},
+	{Header: "STATUS", Value: output.Lookup("status", States)},
+	{Header: "FOR", Value: output.Field("duration")},
+	{Header: "LAST POLLED", Value: output.Field("last_polled_time")},
+	{Header: "REASON", Value: output.Field("down_reason")},
+}
+
+// WatchTable adds a column to Table that shows how the status of each monitor
+// has changed since the last refresh, in color if it may be. It's the last
+// column so that color codes can't upset the alignment of the others.
+func WatchTable(color bool) output.Table {
+	t := append(output.Table{}, Table...)
+
+	return append(t, output.Column{Header: "CHANGE", Value: func(row map[string]interface{}) string {
+		was := output.Lookup("previous_status", States)(row)
+		if was == "" {
+			return ""
+		}
+		now := output.Lookup("status", States)(row)
+		change := fmt.Sprintf("%s -> %s", was, now)
+		if !color {
+			return change
+		}
+
+		c := colorYellow
+		switch now {
+		case States[0], States[3]:
+			c = colorRed
+		case States[1]:
+			c = colorGreen
+		}
+
+		return c + change + colorReset
+	}})
+}
@@ -0,0 +1,17 @@
+package status
+
+// States maps monitor status codes to friendly names
+// https://www.site24x7.com/help/api/#status_constants
+var States = map[int]string{
+	0:  "Down",
+	1:  "Up",
+	2:  "Trouble",
+	3:  "Critical",
+	5:  "Suspended",
+	7:  "Maintenance",
+	9:  "Discovery",
+	10: "Configuration Error",
+}
+
+// stateOrder lists the states in order of severity, for summaries
+var stateOrder = []int{0, 3, 2, 10, 7, 9, 5, 1}
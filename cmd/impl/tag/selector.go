@@ -0,0 +1,99 @@
+package tag
+
+import (
+	"context"
+	"fmt"
+	"site24x7/api"
+	"site24x7/logger"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// requirement is a single term of a selector; a resource meets it when it
+// carries one of the matching tags or, when it's negated, none of them
+type requirement struct {
+	ids     map[string]bool
+	negated bool
+}
+
+// Selector selects resources by their tags. It's written as a comma-separated
+// list of terms, each of which a resource must meet: env=prod (tagged
+// env=prod), env (tagged env, whatever its value) or env!=prod (not tagged
+// env=prod).
+type Selector []requirement
+
+// GetSelectorFlags returns the flags that select resources by their tags
+func GetSelectorFlags() *pflag.FlagSet {
+	fs := pflag.NewFlagSet("selector", pflag.ContinueOnError)
+	fs.String("selector", "", "Only list resources with matching tags, e.g. env=prod,tier or env!=dev")
+
+	return fs
+}
+
+// ParseSelector reads the --selector flag; an empty selector selects every
+// resource
+func ParseSelector(ctx context.Context, fs *pflag.FlagSet) (Selector, error) {
+	s, _ := fs.GetString("selector")
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+
+	tags, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseSelector(s, tags)
+}
+
+// parseSelector reads a selector, matching its terms with a list of tags
+func parseSelector(s string, tags []api.Tag) (Selector, error) {
+	var sel Selector
+	for _, term := range strings.Split(s, ",") {
+		term = strings.TrimSpace(term)
+
+		var r requirement
+		name, value, anyValue := term, "", true
+		if i := strings.Index(term, "!="); i >= 0 {
+			name, value, anyValue, r.negated = term[:i], term[i+2:], false, true
+		} else if i := strings.Index(term, "="); i >= 0 {
+			name, value, anyValue = term[:i], term[i+1:], false
+		}
+		if name == "" {
+			return nil, fmt.Errorf("invalid selector (%s); expected terms such as env=prod, env or env!=prod", s)
+		}
+
+		r.ids = map[string]bool{}
+		for _, t := range tags {
+			if strings.EqualFold(t.Name, name) && (anyValue || strings.EqualFold(t.Value, value)) {
+				r.ids[t.ID] = true
+			}
+		}
+		if len(r.ids) == 0 && !r.negated {
+			logger.Warn(fmt.Sprintf("No tags match %s; see `site24x7 tag list`", term))
+		}
+
+		sel = append(sel, r)
+	}
+
+	return sel, nil
+}
+
+// Matches reports whether a resource with the given tags is selected
+func (s Selector) Matches(tagIDs []string) bool {
+	for _, r := range s {
+		tagged := false
+		for _, id := range tagIDs {
+			if r.ids[id] {
+				tagged = true
+				break
+			}
+		}
+		if tagged == r.negated {
+			return false
+		}
+	}
+
+	return true
+}
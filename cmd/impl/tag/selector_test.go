@@ -0,0 +1,50 @@
+package tag
+
+import (
+	"site24x7/api"
+	"testing"
+)
+
+func TestSelector_Matches(t *testing.T) {
+	tags := []api.Tag{
+		{ID: "1", Name: "env", Value: "prod"},
+		{ID: "2", Name: "env", Value: "dev"},
+		{ID: "3", Name: "tier", Value: "web"},
+		{ID: "4", Name: "pci"},
+	}
+
+	tests := []struct {
+		name     string
+		selector string
+		tagIDs   []string
+		want     bool
+		wantErr  bool
+	}{
+		{name: "Matches a name and value", selector: "env=prod", tagIDs: []string{"3", "1"}, want: true},
+		{name: "Ignores case", selector: "ENV=Prod", tagIDs: []string{"1"}, want: true},
+		{name: "Rejects another value", selector: "env=prod", tagIDs: []string{"2"}, want: false},
+		{name: "Matches a name with any value", selector: "env", tagIDs: []string{"2"}, want: true},
+		{name: "Matches a tag without a value", selector: "pci", tagIDs: []string{"4"}, want: true},
+		{name: "Requires every term", selector: "env=prod, tier", tagIDs: []string{"1"}, want: false},
+		{name: "Matches every term", selector: "env=prod,tier", tagIDs: []string{"1", "3"}, want: true},
+		{name: "Matches a negated term", selector: "env!=prod", tagIDs: []string{"2"}, want: true},
+		{name: "Rejects a negated term", selector: "env!=prod", tagIDs: []string{"1", "2"}, want: false},
+		{name: "Matches an untagged resource only with a negated term", selector: "env!=dev", want: true},
+		{name: "Matches nothing for an unknown tag", selector: "team=payments", tagIDs: []string{"1", "2", "3", "4"}, want: false},
+		{name: "Rejects a term without a name", selector: "env=prod,=web", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sel, err := parseSelector(tt.selector, tags)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseSelector() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := sel.Matches(tt.tagIDs); got != tt.want {
+				t.Errorf("Selector.Matches(%v) = %v, want %v", tt.tagIDs, got, tt.want)
+			}
+		})
+	}
+}
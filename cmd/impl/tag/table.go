@@ -0,0 +1,11 @@
+package tag
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for tags
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("tag_id")},
+	{Header: "NAME", Value: output.Field("tag_name")},
+	{Header: "VALUE", Value: output.Field("tag_value")},
+	{Header: "COLOR", Value: output.Field("tag_color")},
+}
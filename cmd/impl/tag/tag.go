@@ -0,0 +1,215 @@
+package tag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/logger"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiTagList = api.TagList
+var apiTagGet = api.TagGet
+var apiTagCreate = api.TagCreate
+var apiTagUpdate = api.TagUpdate
+var apiTagDelete = api.TagDelete
+
+// list returns a slice containing all tags on the account
+var list = func(ctx context.Context) ([]api.Tag, error) {
+	data, err := apiTagList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []api.Tag
+	if err = json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("[tag.list] Unable to  parse response data (%s)", err)
+	}
+
+	return tags, nil
+}
+
+// get fetches a tag
+var get = func(ctx context.Context, id string) (*api.Tag, error) {
+	var t api.Tag
+
+	data, err := apiTagGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &t); err != nil {
+		return nil, fmt.Errorf("[tag.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &t, nil
+}
+
+// label returns the name by which a tag is referred to, e.g. env=prod, or just
+// env when the tag has no value
+func label(t api.Tag) string {
+	if t.Value == "" {
+		return t.Name
+	}
+
+	return t.Name + "=" + t.Value
+}
+
+// resolver translates tag names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a tag's name and value, as
+// env=prod, or as env:prod as Site24x7 displays it
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "tag",
+		Command: "tag list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			tags, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(tags))
+			for i, t := range tags {
+				names := []string{label(t)}
+				if t.Value != "" {
+					names = append(names, t.Name+":"+t.Value)
+				}
+				refs[i] = impl.Reference{ID: t.ID, Names: names}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// Resolve translates a tag's name and value (or ID) into its ID
+func Resolve(ctx context.Context, ref string) (string, error) {
+	return resolver.Resolve(ctx, ref)
+}
+
+// ResolveAll translates tag names and values (or IDs) into IDs
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.String("value", "", "Value of the tag, e.g. prod for an env tag")
+	writerFlags.String("color", "", "Color of the tag, e.g. #B7DA9E")
+
+	return writerFlags
+}
+
+// hydrate sets a tag property from a flag
+func hydrate(t *api.Tag, fs *pflag.FlagSet, f *pflag.Flag) {
+	switch f.Name {
+	case "name":
+		t.Name, _ = fs.GetString(f.Name)
+	case "value":
+		t.Value, _ = fs.GetString(f.Name)
+	case "color":
+		t.Color, _ = fs.GetString(f.Name)
+	}
+}
+
+// Create is the implementation of the `tag create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	t := &api.Tag{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		hydrate(t, fs, f)
+	})
+	if t.Name == "" {
+		return nil, fmt.Errorf("a tag requires a name")
+	}
+
+	data, err := apiTagCreate(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated tag struct
+	var tag api.Tag
+	if err = json.Unmarshal(data, &tag); err != nil {
+		return nil, fmt.Errorf("[tag.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(tag, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `tag get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	t, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(t, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `tag update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[tag.Update] Updating tag with ID %s", id))
+
+	t, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[tag.Update] Fetched tag %+v", t))
+
+	// Hydrate the tag, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		hydrate(t, fs, f)
+	})
+	if t.Name == "" {
+		return nil, fmt.Errorf("a tag requires a name")
+	}
+
+	data, err := apiTagUpdate(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated tag struct
+	var tOut api.Tag
+	if err = json.Unmarshal(data, &tOut); err != nil {
+		return nil, fmt.Errorf("[tag.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(tOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `tag delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiTagDelete(ctx, id)
+}
+
+// List is the implementation of the `tag list` command
+func List(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
+	tags, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(tags, "", "    ")
+
+	return j, nil
+}
@@ -0,0 +1,69 @@
+package tag
+
+import (
+	"context"
+	"encoding/json"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	list = func(ctx context.Context) ([]api.Tag, error) {
+		return []api.Tag{
+			{ID: "1", Name: "env", Value: "prod"},
+			{ID: "2", Name: "env", Value: "dev"},
+			{ID: "3", Name: "pci"},
+		}, nil
+	}
+	resolver = newResolver()
+
+	tests := []struct {
+		ref        string
+		want       string
+		wantErrMsg string
+	}{
+		{ref: "env=prod", want: "1"},
+		{ref: "env:dev", want: "2"},
+		{ref: "PCI", want: "3"},
+		{ref: "42", want: "42"},
+		{ref: "env", wantErrMsg: "unknown tag"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.ref, func(t *testing.T) {
+			got, err := Resolve(context.Background(), tt.ref)
+			if tt.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Fatalf("Resolve() error = %v, want %q", err, tt.wantErrMsg)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Resolve() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	get = func(ctx context.Context, id string) (*api.Tag, error) {
+		return &api.Tag{ID: id, Name: "env", Value: "prod", Color: "#B7DA9E"}, nil
+	}
+	var sent *api.Tag
+	apiTagUpdate = func(ctx context.Context, tag *api.Tag) (json.RawMessage, error) {
+		sent = tag
+		return json.Marshal(tag)
+	}
+
+	fs := GetWriterFlags()
+	fs.String("name", "", "")
+	fs.Parse([]string{"--value", "production"})
+	if _, err := Update(context.Background(), "1", fs); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	want := api.Tag{ID: "1", Name: "env", Value: "production", Color: "#B7DA9E"}
+	if *sent != want {
+		t.Errorf("Update() sent %+v, want %+v", *sent, want)
+	}
+}
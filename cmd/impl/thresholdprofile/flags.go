@@ -0,0 +1,71 @@
+package thresholdprofile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// GetWriterFlags returns the default flagset that's passed to commands that
+// write information.
+func GetWriterFlags() *pflag.FlagSet {
+	writerFlags := pflag.NewFlagSet("writerFlags", pflag.ExitOnError)
+
+	writerFlags.StringP("type", "t", "URL", "Type of monitor to which the profile applies; see https://www.site24x7.com/help/api/#monitor-types")
+	writerFlags.Int("profile-type", 1, "1 (Static) or 2 (AI-based)")
+	writerFlags.Int("down-location-threshold", 1, "Number of locations that must report a monitor down before it's considered down")
+	writerFlags.Bool("website-content-modified", false, "Alert when the content of a website changes")
+	writerFlags.Int("response-time-trouble", 0, "Response time (ms) above which a monitor is considered in trouble from its primary location")
+	writerFlags.Int("secondary-response-time-trouble", 0, "Response time (ms) above which a monitor is considered in trouble from its secondary locations")
+	writerFlags.Int("response-time-strategy", 1, "How response time thresholds are evaluated; see https://www.site24x7.com/help/api/#threshold_strategy_constants")
+	writerFlags.Int("response-time-polls", 1, "Number of polls (or minutes, depending on the strategy) over which response time thresholds are evaluated")
+
+	return writerFlags
+}
+
+// normalizeName maps a flag name to a property name
+func normalizeName(f *pflag.Flag) string {
+	switch f.Name {
+	// Handle nested properties cleanly
+	case "response-time-trouble":
+		return "PrimaryResponseTimeTroubleThreshold"
+	case "secondary-response-time-trouble":
+		return "SecondaryResponseTimeTroubleThreshold"
+
+	// Everything else aligns pretty well with a "-" to CamelCase inflection
+	default:
+		t := cases.Title(language.English).String(f.Name)
+		return strings.Replace(t, "-", "", -1)
+	}
+}
+
+// validateWriters validates writable values passed to the command via flags.
+// Only flags that were changed are validated; we should be able to safely
+// assume that default values are valid.
+func validateWriters(fs *pflag.FlagSet) error {
+	var err error
+
+	fs.Visit(func(f *pflag.Flag) {
+		switch f.Name {
+		case "profile-type":
+			v, _ := fs.GetInt(f.Name)
+			if _, ok := ProfileTypes[v]; !ok {
+				err = fmt.Errorf("invalid profile type (%d); expected 1 (Static) or 2 (AI-based)", v)
+			}
+		case "response-time-strategy":
+			v, _ := fs.GetInt(f.Name)
+			if _, ok := Strategies[v]; !ok {
+				err = fmt.Errorf("invalid response time strategy (%d); see https://www.site24x7.com/help/api/#threshold_strategy_constants", v)
+			}
+		case "down-location-threshold", "response-time-polls":
+			if v, _ := fs.GetInt(f.Name); v < 1 {
+				err = fmt.Errorf("--%s must be at least 1", f.Name)
+			}
+		}
+	})
+
+	return err
+}
@@ -0,0 +1,13 @@
+package thresholdprofile
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for threshold profiles
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("profile_id")},
+	{Header: "NAME", Value: output.Field("profile_name")},
+	{Header: "MONITOR TYPE", Value: output.Field("type")},
+	{Header: "PROFILE TYPE", Value: output.Lookup("profile_type", ProfileTypes)},
+	{Header: "DOWN LOCATIONS", Value: output.Field("down_location_threshold")},
+	{Header: "RESPONSE TIME (MS)", Value: output.Field("primary_response_time_trouble_threshold.value")},
+}
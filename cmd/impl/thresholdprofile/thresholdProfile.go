@@ -0,0 +1,187 @@
+package thresholdprofile
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/logger"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Alias upstream functions for mocking
+
+var apiThresholdProfileList = api.ThresholdProfileList
+var apiThresholdProfileGet = api.ThresholdProfileGet
+var apiThresholdProfileCreate = api.ThresholdProfileCreate
+var apiThresholdProfileUpdate = api.ThresholdProfileUpdate
+var apiThresholdProfileDelete = api.ThresholdProfileDelete
+
+// list returns a slice containing all threshold profiles on the account
+var list = func(ctx context.Context) ([]api.ThresholdProfile, error) {
+	data, err := apiThresholdProfileList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []api.ThresholdProfile
+	if err = json.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("[thresholdprofile.list] Unable to  parse response data (%s)", err)
+	}
+
+	return profiles, nil
+}
+
+// get fetches a threshold profile
+var get = func(ctx context.Context, id string) (*api.ThresholdProfile, error) {
+	var tp api.ThresholdProfile
+
+	data, err := apiThresholdProfileGet(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated struct
+	if err = json.Unmarshal(data, &tp); err != nil {
+		return nil, fmt.Errorf("[thresholdprofile.get] Unable to  parse response data (%s)", err)
+	}
+
+	return &tp, nil
+}
+
+// hydrate sets a profile property from a flag
+func hydrate(tp *api.ThresholdProfile, fs *pflag.FlagSet, f *pflag.Flag) {
+	property := normalizeName(f)
+	value := impl.TypedFlagValue(fs, f)
+
+	switch property {
+	case "Type":
+		impl.SetProperty(tp, property, strings.ToUpper(value.(string)))
+	case "PrimaryResponseTimeTroubleThreshold", "SecondaryResponseTimeTroubleThreshold":
+		// A response time threshold is a nested condition; a zero value
+		// simply means that it wasn't requested
+		if value.(int) == 0 {
+			return
+		}
+
+		strategy, _ := fs.GetInt("response-time-strategy")
+		polls, _ := fs.GetInt("response-time-polls")
+		impl.SetProperty(tp, property, &api.ThresholdCondition{
+			Strategy:           strategy,
+			ComparisonOperator: 1, // greater than
+			Value:              value.(int),
+			PollsCheck:         polls,
+		})
+	case "ResponseTimeStrategy", "ResponseTimePolls":
+		// Not profile properties; these shape the conditions above, including
+		// any that already exist
+		for _, c := range []*api.ThresholdCondition{tp.PrimaryResponseTimeTroubleThreshold, tp.SecondaryResponseTimeTroubleThreshold} {
+			if c == nil {
+				continue
+			}
+			if property == "ResponseTimeStrategy" {
+				c.Strategy = value.(int)
+			} else {
+				c.PollsCheck = value.(int)
+			}
+		}
+	default:
+		impl.SetProperty(tp, property, value)
+	}
+}
+
+// Create is the implementation of the `threshold_profile create` command
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	tp := &api.ThresholdProfile{Name: name}
+	fs.VisitAll(func(f *pflag.Flag) {
+		hydrate(tp, fs, f)
+	})
+
+	data, err := apiThresholdProfileCreate(ctx, tp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var profile api.ThresholdProfile
+	if err = json.Unmarshal(data, &profile); err != nil {
+		return nil, fmt.Errorf("[thresholdprofile.Create] Unable to  parse response data (%s)", err)
+	}
+
+	// Return json for display purposes
+	j, _ := json.MarshalIndent(profile, "", "    ")
+
+	return j, nil
+}
+
+// Get is the implementation of the `threshold_profile get` command
+func Get(ctx context.Context, id string) ([]byte, error) {
+	tp, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(tp, "", "    ")
+
+	return j, nil
+}
+
+// Update is the implementation of the `threshold_profile update` command
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
+	logger.Info(fmt.Sprintf("[thresholdprofile.Update] Updating profile with ID %s", id))
+
+	if err := validateWriters(fs); err != nil {
+		return nil, err
+	}
+
+	tp, err := get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Debug(fmt.Sprintf("[thresholdprofile.Update] Fetched profile %+v", tp))
+
+	// Hydrate the profile, updating ONLY flags that were set
+	fs.Visit(func(f *pflag.Flag) {
+		hydrate(tp, fs, f)
+	})
+
+	data, err := apiThresholdProfileUpdate(ctx, tp)
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure that we have a fully hydrated profile struct
+	var tpOut api.ThresholdProfile
+	if err = json.Unmarshal(data, &tpOut); err != nil {
+		return nil, fmt.Errorf("[thresholdprofile.Update] Unable to  parse response data (%s)", err)
+	}
+
+	j, _ := json.MarshalIndent(tpOut, "", "    ")
+
+	return j, nil
+}
+
+// Delete is the implementation of the `threshold_profile delete` command
+func Delete(ctx context.Context, id string) error {
+	return apiThresholdProfileDelete(ctx, id)
+}
+
+// List is the implementation of the `threshold_profile list` command
+func List(ctx context.Context) ([]byte, error) {
+	profiles, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	j, _ := json.MarshalIndent(profiles, "", "    ")
+
+	return j, nil
+}
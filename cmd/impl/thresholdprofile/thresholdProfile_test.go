@@ -0,0 +1,248 @@
+package thresholdprofile
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+func Test_list(t *testing.T) {
+	mockAPIResponse := []byte(`[
+		{"profile_name": "Test 1", "type": "URL"},
+		{"profile_name": "Test 2", "type": "SSL_CERT"}
+	]`)
+	mockList := []api.ThresholdProfile{
+		{Name: "Test 1", Type: "URL"},
+		{Name: "Test 2", Type: "SSL_CERT"},
+	}
+
+	tests := []struct {
+		name       string
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
+		want       []api.ThresholdProfile
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return nil, errors.New("testing")
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "testing",
+		},
+		{
+			name: "Returns a list of threshold profiles",
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockList,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiThresholdProfileList = tt.apiListFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := list(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("list() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("list() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		flags       map[string]string
+		apiCreateFn func(ctx context.Context, tp *api.ThresholdProfile) (json.RawMessage, error)
+		want        *api.ThresholdProfile
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name:       "Rejects an invalid profile type",
+			flags:      map[string]string{"profile-type": "7"},
+			wantErr:    true,
+			wantErrMsg: "invalid profile type",
+		},
+		{
+			name:  "Passes along a conflict",
+			flags: map[string]string{},
+			apiCreateFn: func(ctx context.Context, tp *api.ThresholdProfile) (json.RawMessage, error) {
+				return nil, &api.ConflictError{Message: "a threshold profile with that name already exists"}
+			},
+			wantErr:    true,
+			wantErrMsg: "already exists",
+		},
+		{
+			name:  "Creates a profile with response time thresholds",
+			flags: map[string]string{"type": "restapi", "response-time-trouble": "2000", "response-time-polls": "3"},
+			apiCreateFn: func(ctx context.Context, tp *api.ThresholdProfile) (json.RawMessage, error) {
+				// return what was sent
+				j, _ := json.Marshal(tp)
+
+				return j, nil
+			},
+			want: &api.ThresholdProfile{
+				Name:                  "Test Profile",
+				Type:                  "RESTAPI",
+				ProfileType:           1,
+				DownLocationThreshold: 1,
+				PrimaryResponseTimeTroubleThreshold: &api.ThresholdCondition{
+					Strategy:           1,
+					ComparisonOperator: 1,
+					Value:              2000,
+					PollsCheck:         3,
+				},
+			},
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiThresholdProfileCreate = tt.apiCreateFn
+		t.Run(tt.name, func(t *testing.T) {
+			fs := GetWriterFlags()
+			for k, v := range tt.flags {
+				fs.Set(k, v)
+			}
+
+			got, err := Create(context.Background(), "Test Profile", fs)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Create() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+				}
+				return
+			}
+
+			want, _ := json.MarshalIndent(tt.want, "", "    ")
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Create() = %v, want %v", string(got), string(want))
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	mockAPIResponse := []byte(`{"profile_id": "1001001SOS", "profile_name": "TESTING", "type": "URL"}`)
+	var mockProfile api.ThresholdProfile
+	json.Unmarshal(mockAPIResponse, &mockProfile)
+	mockJSON, _ := json.MarshalIndent(mockProfile, "", "    ")
+
+	tests := []struct {
+		name       string
+		apiGetFn   func(ctx context.Context, id string) (json.RawMessage, error)
+		want       []byte
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Handles an API error",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return nil, &api.NotFoundError{Message: "threshold profile not found"}
+			},
+			want:       nil,
+			wantErr:    true,
+			wantErrMsg: "not found",
+		},
+		{
+			name: "Returns formatted json",
+			apiGetFn: func(ctx context.Context, id string) (json.RawMessage, error) {
+				return mockAPIResponse, nil
+			},
+			want:    mockJSON,
+			wantErr: false,
+		},
+	}
+	for _, tt := range tests {
+		apiThresholdProfileGet = tt.apiGetFn
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Get(context.Background(), "1001001SOS")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Get() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Get() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	fs := GetWriterFlags()
+	fs.Set("response-time-strategy", "2")
+	fs.Set("down-location-threshold", "2")
+
+	get = func(ctx context.Context, id string) (*api.ThresholdProfile, error) {
+		return &api.ThresholdProfile{
+			ID:                    id,
+			Name:                  "Test",
+			Type:                  "URL",
+			ProfileType:           1,
+			DownLocationThreshold: 1,
+			PrimaryResponseTimeTroubleThreshold: &api.ThresholdCondition{
+				Strategy: 1, ComparisonOperator: 1, Value: 5000, PollsCheck: 1,
+			},
+		}, nil
+	}
+	apiThresholdProfileUpdate = func(ctx context.Context, tp *api.ThresholdProfile) (json.RawMessage, error) {
+		j, _ := json.Marshal(tp)
+
+		return j, nil
+	}
+
+	want, _ := json.MarshalIndent(&api.ThresholdProfile{
+		ID:                    "1001001SOS",
+		Name:                  "Test",
+		Type:                  "URL",
+		ProfileType:           1,
+		DownLocationThreshold: 2,
+		PrimaryResponseTimeTroubleThreshold: &api.ThresholdCondition{
+			Strategy: 2, ComparisonOperator: 1, Value: 5000, PollsCheck: 1,
+		},
+	}, "", "    ")
+
+	got, err := Update(context.Background(), "1001001SOS", fs)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Update() = %v, want %v", string(got), string(want))
+	}
+}
+
+func TestDelete(t *testing.T) {
+	apiThresholdProfileDelete = func(ctx context.Context, id string) error {
+		return errors.New("testing")
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err == nil {
+		t.Errorf("Delete() expected an error")
+	}
+
+	apiThresholdProfileDelete = func(ctx context.Context, id string) error {
+		return nil
+	}
+	if err := Delete(context.Background(), "1001001SOS"); err != nil {
+		t.Errorf("Delete() error = %v", err)
+	}
+}
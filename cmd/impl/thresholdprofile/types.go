@@ -0,0 +1,16 @@
+package thresholdprofile
+
+// ProfileTypes maps profile type ids to friendly names
+var ProfileTypes = map[int]string{
+	1: "Static",
+	2: "AI-based",
+}
+
+// Strategies maps threshold strategy ids to friendly names
+// https://www.site24x7.com/help/api/#threshold_strategy_constants
+var Strategies = map[int]string{
+	1: "Poll Count",
+	2: "Poll Average",
+	3: "Time",
+	4: "Average Time",
+}
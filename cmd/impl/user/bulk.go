@@ -0,0 +1,298 @@
+package user
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// The results of processing a row of a bulk operation
+const (
+	rowCreated = "created"
+	rowDeleted = "deleted"
+	rowFailed  = "failed"
+	rowSkipped = "skipped"
+)
+
+// rowResult reports the outcome of a single row of a bulk operation
+type rowResult struct {
+	Row    int    `json:"row"`
+	Email  string `json:"email_address"`
+	ID     string `json:"user_id,omitempty"`
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// columnNames maps columns that don't share the name of a flag to one
+var columnNames = map[string]string{
+	"email-address": "email",
+	"display-name":  "name",
+	"user-role":     "role",
+}
+
+// namedValues maps the flags whose values may be given by name, e.g. a role of
+// "Operator", to the names that they accept
+var namedValues = map[string]map[int]string{
+	"role":                  RoleLookup,
+	"statusiq-role":         StatusIQRoles,
+	"cloudspend-role":       CloudspendRoles,
+	"job-title":             JobTitles,
+	"resource-type":         ResourceTypes,
+	"alert-email-format":    EmailFormats,
+	"notify-by":             NotificationMethods,
+	"alert-methods-down":    NotificationMethods,
+	"alert-methods-trouble": NotificationMethods,
+	"alert-methods-up":      NotificationMethods,
+	"alert-methods-applogs": NotificationMethods,
+	"alert-methods-anomaly": NotificationMethods,
+}
+
+// columnFlag maps a CSV column heading to a flag name, e.g. "Job Title" to
+// job-title
+func columnFlag(heading string) string {
+	name := strings.ToLower(strings.TrimSpace(heading))
+	name = strings.NewReplacer(" ", "-", "_", "-").Replace(name)
+	if n, ok := columnNames[name]; ok {
+		return n
+	}
+
+	return name
+}
+
+// resolveValue translates any names in a value into the identifiers that the
+// flag expects; a value may hold a comma separated list, e.g. "Email,SMS"
+func resolveValue(flag string, value string) (string, error) {
+	names, ok := namedValues[flag]
+	if !ok {
+		return value, nil
+	}
+
+	var ids []string
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if _, err := strconv.Atoi(v); err == nil {
+			ids = append(ids, v)
+			continue
+		}
+
+		found := false
+		for id, name := range names {
+			if strings.EqualFold(name, v) {
+				ids = append(ids, strconv.Itoa(id))
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("invalid %s (%s)", flag, v)
+		}
+	}
+
+	return strings.Join(ids, ","), nil
+}
+
+// create creates a user from a row of values, recovering from the panic of a
+// value that doesn't validate
+func create(ctx context.Context, email string, values map[string]string) (id string, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+
+	fs := GetWriterFlags()
+	for flag, value := range values {
+		if err := fs.Set(flag, value); err != nil {
+			return "", fmt.Errorf("invalid %s (%s)", flag, value)
+		}
+	}
+
+	data, err := Create(ctx, email, fs)
+	if err != nil {
+		return "", err
+	}
+
+	var u struct {
+		ID string `json:"user_id"`
+	}
+	json.Unmarshal(data, &u)
+
+	return u.ID, nil
+}
+
+// Import is the implementation of the `user import` command. Each row of a CSV
+// file, after a heading row, describes a user; columns are named for the flags
+// of `user create`, plus an email column, e.g.
+//
+//	email,name,role,job title,notify by
+//	fred@example.com,Fred Flintstone,Operator,DevOps Engineer,"Email,SMS"
+//
+// Processing stops at the first row that fails unless continueOnError is set,
+// and when the context is cancelled; either way, an error is returned if any
+// row failed.
+func Import(ctx context.Context, path string, continueOnError bool) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("[user.Import] Unable to read %s (%s)", path, err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.TrimLeadingSpace = true
+
+	headings, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("[user.Import] Unable to read the heading row of %s (%s)", path, err)
+	}
+
+	// Validate the headings before creating anyone
+	flags := GetWriterFlags()
+	columns := make([]string, len(headings))
+	hasEmail := false
+	for i, h := range headings {
+		columns[i] = columnFlag(h)
+		if columns[i] == "email" {
+			hasEmail = true
+		} else if flags.Lookup(columns[i]) == nil {
+			return nil, fmt.Errorf("unknown column (%s); columns must be named for the flags of `user create`, or email", h)
+		}
+	}
+	if !hasEmail {
+		return nil, fmt.Errorf("an email column is required")
+	}
+
+	results := []rowResult{}
+	var failed int
+	for row := 2; ; row++ {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+
+		result := rowResult{Row: row}
+		for i, v := range record {
+			if i < len(columns) && columns[i] == "email" {
+				result.Email = strings.TrimSpace(v)
+			}
+		}
+
+		if err != nil {
+			result.Result, result.Error = rowFailed, err.Error()
+		} else if (failed > 0 && !continueOnError) || ctx.Err() != nil {
+			result.Result = rowSkipped
+		} else {
+			values := map[string]string{}
+			for i, v := range record {
+				v = strings.TrimSpace(v)
+				if i >= len(columns) || columns[i] == "email" || v == "" {
+					continue
+				}
+				if values[columns[i]], err = resolveValue(columns[i], v); err != nil {
+					break
+				}
+			}
+
+			if err == nil && result.Email == "" {
+				err = fmt.Errorf("no email address")
+			}
+			if err == nil {
+				result.ID, err = create(ctx, result.Email, values)
+			}
+
+			if err != nil {
+				result.Result, result.Error = rowFailed, err.Error()
+			} else {
+				result.Result = rowCreated
+			}
+		}
+		if result.Result == rowFailed {
+			failed++
+		}
+
+		results = append(results, result)
+	}
+
+	j, _ := json.MarshalIndent(results, "", "    ")
+
+	if err := ctx.Err(); err != nil {
+		return j, err
+	}
+	if failed > 0 {
+		return j, fmt.Errorf("%d of %d users couldn't be imported", failed, len(results))
+	}
+
+	return j, nil
+}
+
+// DeleteFromFile is the implementation of `user delete --from-file`. The file
+// holds an email address per line; blank lines and lines beginning with # are
+// ignored. Processing stops at the first user that can't be deleted unless
+// continueOnError is set, and when the context is cancelled; either way, an
+// error is returned if any failed.
+func DeleteFromFile(ctx context.Context, path string, continueOnError bool) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("[user.DeleteFromFile] Unable to read %s (%s)", path, err)
+	}
+	defer f.Close()
+
+	// Users are looked up by email address from a single listing, rather than
+	// one per row, which would soon be throttled
+	users, err := list(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := map[string]string{}
+	for _, u := range users {
+		ids[strings.ToLower(u.EmailAddress)] = u.ID
+	}
+
+	results := []rowResult{}
+	var failed int
+	s := bufio.NewScanner(f)
+	for row := 1; s.Scan(); row++ {
+		email := strings.TrimSpace(s.Text())
+		if email == "" || strings.HasPrefix(email, "#") {
+			continue
+		}
+
+		result := rowResult{Row: row, Email: email}
+		id, found := ids[strings.ToLower(email)]
+		if (failed > 0 && !continueOnError) || ctx.Err() != nil {
+			result.Result = rowSkipped
+		} else if !found {
+			result.Result, result.Error = rowFailed, fmt.Sprintf("user (%s) not found", email)
+		} else if err := apiUserDelete(ctx, id); err != nil {
+			result.ID = id
+			result.Result, result.Error = rowFailed, err.Error()
+		} else {
+			result.ID = id
+			result.Result = rowDeleted
+		}
+		if result.Result == rowFailed {
+			failed++
+		}
+
+		results = append(results, result)
+	}
+	if err := s.Err(); err != nil {
+		return nil, fmt.Errorf("[user.DeleteFromFile] Unable to read %s (%s)", path, err)
+	}
+
+	j, _ := json.MarshalIndent(results, "", "    ")
+
+	if err := ctx.Err(); err != nil {
+		return j, err
+	}
+	if failed > 0 {
+		return j, fmt.Errorf("%d of %d users couldn't be deleted", failed, len(results))
+	}
+
+	return j, nil
+}
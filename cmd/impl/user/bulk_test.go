@@ -0,0 +1,254 @@
+package user
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"site24x7/api"
+	"strings"
+	"testing"
+)
+
+func Test_resolveValue(t *testing.T) {
+	type args struct {
+		flag  string
+		value string
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		want       string
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name: "Passes through a flag without names",
+			args: args{flag: "name", value: "Fred Flintstone"},
+			want: "Fred Flintstone",
+		},
+		{
+			name: "Resolves a role by name",
+			args: args{flag: "role", value: "operator"},
+			want: "3",
+		},
+		{
+			name: "Passes through a role by value",
+			args: args{flag: "role", value: "3"},
+			want: "3",
+		},
+		{
+			name: "Resolves a list of notification methods",
+			args: args{flag: "notify-by", value: "Email, sms"},
+			want: "1,2",
+		},
+		{
+			name:       "Rejects an unknown name",
+			args:       args{flag: "job-title", value: "Wizard"},
+			wantErr:    true,
+			wantErrMsg: "invalid job-title (Wizard)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveValue(tt.args.flag, tt.args.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("resolveValue() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("resolveValue() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if got != tt.want {
+				t.Errorf("resolveValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	type args struct {
+		csv             string
+		continueOnError bool
+	}
+
+	defer func(fn func(context.Context, *api.User) (json.RawMessage, error)) { apiUserCreate = fn }(apiUserCreate)
+
+	// Fails to create anyone at example.org
+	apiUserCreate = func(ctx context.Context, u *api.User) (json.RawMessage, error) {
+		if strings.HasSuffix(u.EmailAddress, "@example.org") {
+			return nil, errors.New("testing")
+		}
+
+		return json.Marshal(api.User{ID: "ID-" + u.EmailAddress, EmailAddress: u.EmailAddress, Role: u.Role})
+	}
+
+	tests := []struct {
+		name       string
+		args       args
+		want       []rowResult
+		wantErr    bool
+		wantErrMsg string
+	}{
+		{
+			name:       "Rejects an unknown column",
+			args:       args{csv: "email,shoe size\nfred@example.com,12\n"},
+			wantErr:    true,
+			wantErrMsg: "unknown column (shoe size)",
+		},
+		{
+			name:       "Requires an email column",
+			args:       args{csv: "name,role\nFred,Operator\n"},
+			wantErr:    true,
+			wantErrMsg: "an email column is required",
+		},
+		{
+			name: "Creates a user for each row",
+			args: args{csv: "Email,Name,Role,Job Title\nfred@example.com,Fred,Operator,Others\nwilma@example.com,Wilma,Administrator,\n"},
+			want: []rowResult{
+				{Row: 2, Email: "fred@example.com", ID: "ID-fred@example.com", Result: rowCreated},
+				{Row: 3, Email: "wilma@example.com", ID: "ID-wilma@example.com", Result: rowCreated},
+			},
+		},
+		{
+			name: "Stops at the first failure",
+			args: args{csv: "email,role\nfred@example.com,Wizard\nwilma@example.com,Administrator\n"},
+			want: []rowResult{
+				{Row: 2, Email: "fred@example.com", Result: rowFailed, Error: "invalid role (Wizard)"},
+				{Row: 3, Email: "wilma@example.com", Result: rowSkipped},
+			},
+			wantErr:    true,
+			wantErrMsg: "1 of 2 users couldn't be imported",
+		},
+		{
+			name: "Continues past failures when asked",
+			args: args{csv: "email,role\nbarney@example.org,Administrator\nwilma@example.com,Administrator\n", continueOnError: true},
+			want: []rowResult{
+				{Row: 2, Email: "barney@example.org", Result: rowFailed, Error: "testing"},
+				{Row: 3, Email: "wilma@example.com", ID: "ID-wilma@example.com", Result: rowCreated},
+			},
+			wantErr:    true,
+			wantErrMsg: "1 of 2 users couldn't be imported",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "users.csv")
+			os.WriteFile(path, []byte(tt.args.csv), 0644)
+
+			got, err := Import(context.Background(), path, tt.args.continueOnError)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Import() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("Import() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			if tt.want == nil {
+				return
+			}
+			var results []rowResult
+			json.Unmarshal(got, &results)
+			if !reflect.DeepEqual(results, tt.want) {
+				t.Errorf("Import() = %+v, want %+v", results, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeleteFromFile(t *testing.T) {
+	type args struct {
+		emails          string
+		continueOnError bool
+	}
+
+	defer func(listFn func(context.Context) ([]api.User, error), deleteFn func(context.Context, string) error) {
+		list, apiUserDelete = listFn, deleteFn
+	}(list, apiUserDelete)
+
+	var lists int
+	list = func(ctx context.Context) ([]api.User, error) {
+		lists++
+
+		return []api.User{
+			{ID: "ID-fred@example.com", EmailAddress: "fred@example.com"},
+			{ID: "ID-wilma@example.com", EmailAddress: "Wilma@Example.com"},
+		}, nil
+	}
+	var deleted []string
+	apiUserDelete = func(ctx context.Context, id string) error {
+		deleted = append(deleted, id)
+
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		want        []rowResult
+		wantDeleted []string
+		wantErr     bool
+		wantErrMsg  string
+	}{
+		{
+			name: "Deletes each user, ignoring blanks and comments",
+			args: args{emails: "# leavers\nfred@example.com\n\nwilma@example.com\n"},
+			want: []rowResult{
+				{Row: 2, Email: "fred@example.com", ID: "ID-fred@example.com", Result: rowDeleted},
+				{Row: 4, Email: "wilma@example.com", ID: "ID-wilma@example.com", Result: rowDeleted},
+			},
+			wantDeleted: []string{"ID-fred@example.com", "ID-wilma@example.com"},
+		},
+		{
+			name: "Stops at the first failure",
+			args: args{emails: "nobody@example.com\nfred@example.com\n"},
+			want: []rowResult{
+				{Row: 1, Email: "nobody@example.com", Result: rowFailed, Error: "user (nobody@example.com) not found"},
+				{Row: 2, Email: "fred@example.com", Result: rowSkipped},
+			},
+			wantErr:    true,
+			wantErrMsg: "1 of 2 users couldn't be deleted",
+		},
+		{
+			name: "Continues past failures when asked",
+			args: args{emails: "nobody@example.com\nfred@example.com\n", continueOnError: true},
+			want: []rowResult{
+				{Row: 1, Email: "nobody@example.com", Result: rowFailed, Error: "user (nobody@example.com) not found"},
+				{Row: 2, Email: "fred@example.com", ID: "ID-fred@example.com", Result: rowDeleted},
+			},
+			wantDeleted: []string{"ID-fred@example.com"},
+			wantErr:     true,
+			wantErrMsg:  "1 of 2 users couldn't be deleted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted, lists = nil, 0
+			path := filepath.Join(t.TempDir(), "emails.txt")
+			os.WriteFile(path, []byte(tt.args.emails), 0644)
+
+			got, err := DeleteFromFile(context.Background(), path, tt.args.continueOnError)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("DeleteFromFile() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !strings.Contains(err.Error(), tt.wantErrMsg) {
+				t.Errorf("DeleteFromFile() error = %v, wantErrMsg \"%s\"", err, tt.wantErrMsg)
+			}
+			var results []rowResult
+			json.Unmarshal(got, &results)
+			if !reflect.DeepEqual(results, tt.want) {
+				t.Errorf("DeleteFromFile() = %+v, want %+v", results, tt.want)
+			}
+			if !reflect.DeepEqual(deleted, tt.wantDeleted) {
+				t.Errorf("DeleteFromFile() deleted %v, want %v", deleted, tt.wantDeleted)
+			}
+			if lists != 1 {
+				t.Errorf("DeleteFromFile() listed users %d times, want 1", lists)
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ func GetWriterFlags() *pflag.FlagSet {
 	writerFlags.StringP("name", "n", "Unnamed User", "Full name (first last) of the user, e.g. \"Fred Flintstone\"")
 	writerFlags.IntP("role", "r", 0, "See https://www.site24x7.com/help/api/#user_constants")
 	writerFlags.IntSliceP("notify-by", "N", []int{1}, "Medium by which the user will receive alerts")
-	writerFlags.StringSliceP("monitor-groups", "g", []string{}, "List of monitor group identifiers to which the user should be assigned for receiving alerts")
+	writerFlags.StringSliceP("monitor-groups", "g", []string{}, "IDs or names of the monitor groups to which the user should be assigned for receiving alerts")
 	writerFlags.Int("alert-email-format", 1, "See https://www.site24x7.com/help/api/#alerting_constants")
 	writerFlags.IntSlice("alert-skip-days", []int{}, "Days of the week on which the user should not be sent alerts: 0 (Sunday)-6 (Saturday) (default none")
 	writerFlags.String("alert-start-time", "00:00", "The time of day when the user should start receiving alerts")
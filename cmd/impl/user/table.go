@@ -0,0 +1,24 @@
+package user
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for users
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("user_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "EMAIL", Value: output.Field("email_address")},
+	{Header: "ROLE", Value: output.Lookup("user_role", RoleLookup)},
+	{Header: "JOB TITLE", Value: output.Lookup("job_title", JobTitles)},
+	{Header: "NOTIFY BY", Value: output.Lookup("notify_medium", NotificationMethods)},
+	{Header: "MONITOR GROUPS", Value: output.Count("user_groups")},
+}
+
+// BulkTable defines the default columns displayed for the rows of a bulk
+// import or deletion
+var BulkTable = output.Table{
+	{Header: "ROW", Value: output.Field("row")},
+	{Header: "EMAIL", Value: output.Field("email_address")},
+	{Header: "ID", Value: output.Field("user_id")},
+	{Header: "RESULT", Value: output.Field("result")},
+	{Header: "ERROR", Value: output.Field("error")},
+}
@@ -1,10 +1,12 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"site24x7/api"
 	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/monitorgroup"
 
 	"strings"
 
@@ -18,10 +20,11 @@ var apiUserGet = api.UserGet
 var apiUserCreate = api.UserCreate
 var apiUserUpdate = api.UserUpdate
 var apiUserDelete = api.UserDelete
+var resolveMonitorGroups = monitorgroup.ResolveAll
 
 // list returns a slice containing all users on the account
-var list = func() ([]api.User, error) {
-	data, err := apiUserList()
+var list = func(ctx context.Context) ([]api.User, error) {
+	data, err := apiUserList(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,8 +38,8 @@ var list = func() ([]api.User, error) {
 }
 
 // findByEmail returns a user with a given email address
-var findByEmail = func(email string) (*api.User, error) {
-	users, err := list()
+var findByEmail = func(ctx context.Context, email string) (*api.User, error) {
+	users, err := list(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -52,12 +55,12 @@ var findByEmail = func(email string) (*api.User, error) {
 }
 
 // get fetches a user either by email address or by identifier
-var get = func(id string, email string) (*api.User, error) {
+var get = func(ctx context.Context, id string, email string) (*api.User, error) {
 	var u api.User
 
 	if email != "" {
 		// Fetch by email address
-		r, err := findByEmail(email)
+		r, err := findByEmail(ctx, email)
 		if err != nil {
 			return nil, err
 		}
@@ -66,7 +69,7 @@ var get = func(id string, email string) (*api.User, error) {
 		u = *r
 	} else {
 		// Fetch by user ID - a.k.a, the official way
-		data, err := apiUserGet(id)
+		data, err := apiUserGet(ctx, id)
 		if err != nil {
 			return nil, err
 		}
@@ -80,8 +83,39 @@ var get = func(id string, email string) (*api.User, error) {
 	return &u, nil
 }
 
+// resolver translates user email addresses and names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a user's email address or,
+// failing that, their display name
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "user",
+		Command: "user list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			users, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(users))
+			for i, u := range users {
+				refs[i] = impl.Reference{ID: u.ID, Names: []string{u.EmailAddress, u.Name}}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// ResolveAll translates user email addresses or display names (or IDs) into
+// IDs
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
+
 // Create is the implementation of the `user create` command
-func Create(email string, fs *pflag.FlagSet) ([]byte, error) {
+func Create(ctx context.Context, email string, fs *pflag.FlagSet) ([]byte, error) {
 	// Panics if a flag doesn't validate
 	validateWriters(fs)
 
@@ -107,7 +141,12 @@ func Create(email string, fs *pflag.FlagSet) ([]byte, error) {
 		}
 	})
 
-	data, err := apiUserCreate(u)
+	var err error
+	if u.MonitorGroups, err = resolveMonitorGroups(ctx, u.MonitorGroups); err != nil {
+		return nil, err
+	}
+
+	data, err := apiUserCreate(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -125,13 +164,13 @@ func Create(email string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Get is the implementation of the `user get` command
-func Get(fs *pflag.FlagSet) ([]byte, error) {
+func Get(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
 	validateAccessors(fs)
 
 	id, _ := fs.GetString("id")
 	email, _ := fs.GetString("email")
 
-	u, err := get(id, email)
+	u, err := get(ctx, id, email)
 	if err != nil {
 		return nil, err
 	}
@@ -142,13 +181,13 @@ func Get(fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Update is the implementation of the `user update` command
-func Update(fs *pflag.FlagSet) ([]byte, error) {
+func Update(ctx context.Context, fs *pflag.FlagSet) ([]byte, error) {
 	validateAccessors(fs)
 	validateWriters(fs)
 
 	id, _ := fs.GetString("id")
 	email, _ := fs.GetString("email")
-	u, err := get(id, email)
+	u, err := get(ctx, id, email)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +207,11 @@ func Update(fs *pflag.FlagSet) ([]byte, error) {
 		}
 	})
 
-	data, err := apiUserUpdate(u)
+	if u.MonitorGroups, err = resolveMonitorGroups(ctx, u.MonitorGroups); err != nil {
+		return nil, err
+	}
+
+	data, err := apiUserUpdate(ctx, u)
 	if err != nil {
 		return nil, err
 	}
@@ -185,18 +228,18 @@ func Update(fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Delete is the implementation of the `user delete` command
-func Delete(fs *pflag.FlagSet) error {
+func Delete(ctx context.Context, fs *pflag.FlagSet) error {
 	validateAccessors(fs)
 
 	id, _ := fs.GetString("id")
 	email, _ := fs.GetString("email")
 
-	u, err := get(id, email)
+	u, err := get(ctx, id, email)
 	if err != nil {
 		return err
 	}
 
-	if err := apiUserDelete(u.ID); err != nil {
+	if err := apiUserDelete(ctx, u.ID); err != nil {
 		return err
 	}
 
@@ -204,8 +247,8 @@ func Delete(fs *pflag.FlagSet) error {
 }
 
 // List is the implementation of the `user list` command
-func List() ([]byte, error) {
-	users, err := list()
+func List(ctx context.Context) ([]byte, error) {
+	users, err := list(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package user
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"reflect"
@@ -25,14 +26,14 @@ func Test_list(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		apiListFn  func() (json.RawMessage, error)
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
 		want       []api.User
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "Handles an API error",
-			apiListFn: func() (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -41,7 +42,7 @@ func Test_list(t *testing.T) {
 		},
 		{
 			name: "Returns a list of users",
-			apiListFn: func() (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockUserList,
@@ -51,7 +52,7 @@ func Test_list(t *testing.T) {
 	for _, tt := range tests {
 		apiUserList = tt.apiListFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := list()
+			got, err := list(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -80,7 +81,7 @@ func Test_findByEmail(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		listFn     func() ([]api.User, error)
+		listFn     func(ctx context.Context) ([]api.User, error)
 		want       *api.User
 		wantErr    bool
 		wantErrMsg string
@@ -90,7 +91,7 @@ func Test_findByEmail(t *testing.T) {
 			args: args{
 				email: "aqua@man.com",
 			},
-			listFn: func() ([]api.User, error) {
+			listFn: func(ctx context.Context) ([]api.User, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -102,7 +103,7 @@ func Test_findByEmail(t *testing.T) {
 			args: args{
 				email: "super@man.com",
 			},
-			listFn: func() ([]api.User, error) {
+			listFn: func(ctx context.Context) ([]api.User, error) {
 				return mockUserList, nil
 			},
 			want:       nil,
@@ -114,7 +115,7 @@ func Test_findByEmail(t *testing.T) {
 			args: args{
 				email: "humpty@dumpty.com",
 			},
-			listFn: func() ([]api.User, error) {
+			listFn: func(ctx context.Context) ([]api.User, error) {
 				return mockUserList, nil
 			},
 			want:    &api.User{EmailAddress: "humpty@dumpty.com"},
@@ -124,7 +125,7 @@ func Test_findByEmail(t *testing.T) {
 	for _, tt := range tests {
 		list = tt.listFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := findByEmail(tt.args.email)
+			got, err := findByEmail(context.Background(), tt.args.email)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("findByEmail() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -155,8 +156,8 @@ func Test_get(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		findFn     func(email string) (*api.User, error)
-		apiGetFn   func(userID string) (json.RawMessage, error)
+		findFn     func(ctx context.Context, email string) (*api.User, error)
+		apiGetFn   func(ctx context.Context, userID string) (json.RawMessage, error)
 		want       *api.User
 		wantErr    bool
 		wantErrMsg string
@@ -167,7 +168,7 @@ func Test_get(t *testing.T) {
 				id:    "",
 				email: "foo@bar.com",
 			},
-			findFn: func(email string) (*api.User, error) {
+			findFn: func(ctx context.Context, email string) (*api.User, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -180,7 +181,7 @@ func Test_get(t *testing.T) {
 				id:    "",
 				email: "foo@bar.com",
 			},
-			findFn: func(email string) (*api.User, error) {
+			findFn: func(ctx context.Context, email string) (*api.User, error) {
 				return &mockUser, nil
 			},
 			want:    &mockUser,
@@ -192,7 +193,7 @@ func Test_get(t *testing.T) {
 				id:    "1001001SOS",
 				email: "",
 			},
-			apiGetFn: func(userID string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, userID string) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -205,7 +206,7 @@ func Test_get(t *testing.T) {
 				id:    "1001001SOS",
 				email: "",
 			},
-			apiGetFn: func(userID string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, userID string) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    &mockUser,
@@ -216,7 +217,7 @@ func Test_get(t *testing.T) {
 		findByEmail = tt.findFn
 		apiUserGet = tt.apiGetFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := get(tt.args.id, tt.args.email)
+			got, err := get(context.Background(), tt.args.id, tt.args.email)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -250,7 +251,7 @@ func TestGet(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		getFn      func(id string, email string) (*api.User, error)
+		getFn      func(ctx context.Context, id string, email string) (*api.User, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
@@ -260,7 +261,7 @@ func TestGet(t *testing.T) {
 			args: args{
 				fs: e,
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -272,7 +273,7 @@ func TestGet(t *testing.T) {
 			args: args{
 				fs: e,
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return mockUser, nil
 			},
 			want:    mockUserJSON,
@@ -282,7 +283,7 @@ func TestGet(t *testing.T) {
 	for _, tt := range tests {
 		get = tt.getFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Get(tt.args.fs)
+			got, err := Get(context.Background(), tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -307,14 +308,14 @@ func TestList(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		listFn     func() ([]api.User, error)
+		listFn     func(ctx context.Context) ([]api.User, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "Handles an error from the list function",
-			listFn: func() ([]api.User, error) {
+			listFn: func(ctx context.Context) ([]api.User, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -323,7 +324,7 @@ func TestList(t *testing.T) {
 		},
 		{
 			name: "Returns a list of users",
-			listFn: func() ([]api.User, error) {
+			listFn: func(ctx context.Context) ([]api.User, error) {
 				return mockUserList, nil
 			},
 			want:    mockUserJSON,
@@ -333,7 +334,7 @@ func TestList(t *testing.T) {
 	for _, tt := range tests {
 		list = tt.listFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := List()
+			got, err := List(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -402,7 +403,7 @@ func TestCreate(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		apiCreateFn func(u *api.User) (json.RawMessage, error)
+		apiCreateFn func(ctx context.Context, u *api.User) (json.RawMessage, error)
 		want        []byte
 		wantErr     bool
 		wantErrMsg  string
@@ -413,7 +414,7 @@ func TestCreate(t *testing.T) {
 				email: "oompa@loompa.com",
 				fs:    GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.User) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.User) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -426,7 +427,7 @@ func TestCreate(t *testing.T) {
 				email: "boo@berry.com",
 				fs:    GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.User) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.User) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockUserJSON,
@@ -438,7 +439,7 @@ func TestCreate(t *testing.T) {
 				email: "boo@berry.com",
 				fs:    GetWriterFlagsWithInvalidProperty(),
 			},
-			apiCreateFn: func(u *api.User) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.User) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockUserJSON,
@@ -448,7 +449,7 @@ func TestCreate(t *testing.T) {
 	for _, tt := range tests {
 		apiUserCreate = tt.apiCreateFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Create(tt.args.email, tt.args.fs)
+			got, err := Create(context.Background(), tt.args.email, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -468,6 +469,11 @@ func TestUpdate(t *testing.T) {
 		fs *pflag.FlagSet
 	}
 
+	// Monitor group names are resolved elsewhere; pass them through
+	resolve := resolveMonitorGroups
+	resolveMonitorGroups = func(ctx context.Context, refs []string) ([]string, error) { return refs, nil }
+	t.Cleanup(func() { resolveMonitorGroups = resolve })
+
 	fs := GetAccessorFlags()
 	fs.AddFlagSet(GetWriterFlags())
 
@@ -494,8 +500,8 @@ func TestUpdate(t *testing.T) {
 		name            string
 		args            args
 		before          func()
-		getFn           func(id string, email string) (*api.User, error)
-		apiUserUpdateFn func(u *api.User) (json.RawMessage, error)
+		getFn           func(ctx context.Context, id string, email string) (*api.User, error)
+		apiUserUpdateFn func(ctx context.Context, u *api.User) (json.RawMessage, error)
 		want            []byte
 		wantErr         bool
 		wantErrMsg      string
@@ -508,7 +514,7 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -523,10 +529,10 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return mockUser, nil
 			},
-			apiUserUpdateFn: func(u *api.User) (json.RawMessage, error) {
+			apiUserUpdateFn: func(ctx context.Context, u *api.User) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -552,10 +558,10 @@ func TestUpdate(t *testing.T) {
 				// this flag should be ignored
 				fs.Set("non-eu-alert-consent", "true")
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return mockUser, nil
 			},
-			apiUserUpdateFn: func(u *api.User) (json.RawMessage, error) {
+			apiUserUpdateFn: func(ctx context.Context, u *api.User) (json.RawMessage, error) {
 				// return what was sent
 				j, _ := json.Marshal(u)
 
@@ -570,7 +576,7 @@ func TestUpdate(t *testing.T) {
 		apiUserUpdate = tt.apiUserUpdateFn
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
-			got, err := Update(tt.args.fs)
+			got, err := Update(context.Background(), tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -595,8 +601,8 @@ func TestDelete(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		getFn       func(id string, email string) (*api.User, error)
-		apiDeleteFn func(id string) error
+		getFn       func(ctx context.Context, id string, email string) (*api.User, error)
+		apiDeleteFn func(ctx context.Context, id string) error
 		wantErr     bool
 		wantErrMsg  string
 	}{
@@ -605,10 +611,10 @@ func TestDelete(t *testing.T) {
 			args: args{
 				fs: fs,
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return nil, errors.New("testing")
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				// noop
 				return nil
 			},
@@ -620,10 +626,10 @@ func TestDelete(t *testing.T) {
 			args: args{
 				fs: fs,
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return &api.User{ID: "1001001SOS"}, nil
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return errors.New("testing")
 			},
 			wantErr:    true,
@@ -634,10 +640,10 @@ func TestDelete(t *testing.T) {
 			args: args{
 				fs: fs,
 			},
-			getFn: func(id string, email string) (*api.User, error) {
+			getFn: func(ctx context.Context, id string, email string) (*api.User, error) {
 				return &api.User{ID: "1001001SOS"}, nil
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return nil
 			},
 			wantErr: false,
@@ -647,7 +653,7 @@ func TestDelete(t *testing.T) {
 		get = tt.getFn
 		apiUserDelete = tt.apiDeleteFn
 		t.Run(tt.name, func(t *testing.T) {
-			err := Delete(tt.args.fs)
+			err := Delete(context.Background(), tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -0,0 +1,12 @@
+package usergroup
+
+import "site24x7/cmd/impl/output"
+
+// Table defines the default columns displayed for user groups
+var Table = output.Table{
+	{Header: "ID", Value: output.Field("user_group_id")},
+	{Header: "NAME", Value: output.Field("display_name")},
+	{Header: "PRODUCT", Value: output.Field("product_id")},
+	{Header: "USERS", Value: output.Count("users")},
+	{Header: "ATTRIBUTE GROUP", Value: output.Field("attribute_group_id")},
+}
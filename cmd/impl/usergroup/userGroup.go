@@ -1,10 +1,12 @@
 package usergroup
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"site24x7/api"
 	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/user"
 	"site24x7/logger"
 
 	"github.com/spf13/pflag"
@@ -18,10 +20,11 @@ var apiUserGroupGet = api.UserGroupGet
 var apiUserGroupUpdate = api.UserGroupUpdate
 var apiUserGroupDelete = api.UserGroupDelete
 var apiUserGroupList = api.UserGroupList
+var resolveUsers = user.ResolveAll
 
 // list returns a slice containing all users on the account
-var list = func() ([]api.UserGroup, error) {
-	data, err := apiUserGroupList()
+var list = func(ctx context.Context) ([]api.UserGroup, error) {
+	data, err := apiUserGroupList(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -35,10 +38,10 @@ var list = func() ([]api.UserGroup, error) {
 }
 
 // get fetches a user group
-var get = func(id string) (*api.UserGroup, error) {
+var get = func(ctx context.Context, id string) (*api.UserGroup, error) {
 	var ug api.UserGroup
 
-	data, err := apiUserGroupGet(id)
+	data, err := apiUserGroupGet(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -51,8 +54,42 @@ var get = func(id string) (*api.UserGroup, error) {
 	return &ug, nil
 }
 
+// resolver translates user group names into IDs
+var resolver = newResolver()
+
+// newResolver returns a resolver that matches a user group's display name
+func newResolver() *impl.Resolver {
+	return &impl.Resolver{
+		Kind:    "user group",
+		Command: "user_group list",
+		List: func(ctx context.Context) ([]impl.Reference, error) {
+			usergrus, err := list(ctx)
+			if err != nil {
+				return nil, err
+			}
+
+			refs := make([]impl.Reference, len(usergrus))
+			for i, ug := range usergrus {
+				refs[i] = impl.Reference{ID: ug.ID, Names: []string{ug.Name}}
+			}
+
+			return refs, nil
+		},
+	}
+}
+
+// Resolve translates a user group's display name (or ID) into its ID
+func Resolve(ctx context.Context, ref string) (string, error) {
+	return resolver.Resolve(ctx, ref)
+}
+
+// ResolveAll translates user group display names (or IDs) into IDs
+func ResolveAll(ctx context.Context, refs []string) ([]string, error) {
+	return resolver.ResolveAll(ctx, refs)
+}
+
 // Create is the implementation of the `user_group create` command
-func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
+func Create(ctx context.Context, name string, fs *pflag.FlagSet) ([]byte, error) {
 	ug := &api.UserGroup{Name: name}
 	fs.VisitAll(func(f *pflag.Flag) {
 		property := normalizeName(f)
@@ -61,7 +98,12 @@ func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
 		impl.SetProperty(ug, property, value)
 	})
 
-	data, err := apiUserGroupCreate(ug)
+	var err error
+	if ug.Users, err = resolveUsers(ctx, ug.Users); err != nil {
+		return nil, err
+	}
+
+	data, err := apiUserGroupCreate(ctx, ug)
 	if err != nil {
 		return nil, err
 	}
@@ -79,8 +121,8 @@ func Create(name string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Get is the implementation of the `user_group get` command
-func Get(id string) ([]byte, error) {
-	ug, err := get(id)
+func Get(ctx context.Context, id string) ([]byte, error) {
+	ug, err := get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -91,10 +133,10 @@ func Get(id string) ([]byte, error) {
 }
 
 // Update is the implementation of the `user_group update` command
-func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
+func Update(ctx context.Context, id string, fs *pflag.FlagSet) ([]byte, error) {
 	logger.Info(fmt.Sprintf("[UserGroup.Update] Updating group with ID %s", id))
 
-	ug, err := get(id)
+	ug, err := get(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +151,11 @@ func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
 		impl.SetProperty(ug, property, value)
 	})
 
-	data, err := apiUserGroupUpdate(ug)
+	if ug.Users, err = resolveUsers(ctx, ug.Users); err != nil {
+		return nil, err
+	}
+
+	data, err := apiUserGroupUpdate(ctx, ug)
 	if err != nil {
 		return nil, err
 	}
@@ -126,8 +172,8 @@ func Update(id string, fs *pflag.FlagSet) ([]byte, error) {
 }
 
 // Delete is the implementation of the `monitor_group delete` command
-func Delete(id string) error {
-	err := apiUserGroupDelete(id)
+func Delete(ctx context.Context, id string) error {
+	err := apiUserGroupDelete(ctx, id)
 	if err != nil {
 		return err
 	}
@@ -136,8 +182,8 @@ func Delete(id string) error {
 }
 
 // List is the implementation of the `user_group list` command
-func List() ([]byte, error) {
-	list, err := list()
+func List(ctx context.Context) ([]byte, error) {
+	list, err := list(ctx)
 	if err != nil {
 		return nil, err
 	}
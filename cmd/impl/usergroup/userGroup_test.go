@@ -1,6 +1,7 @@
 package usergroup
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"reflect"
@@ -30,14 +31,14 @@ func Test_list(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		apiListFn  func() (json.RawMessage, error)
+		apiListFn  func(ctx context.Context) (json.RawMessage, error)
 		want       []api.UserGroup
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "Handles an API error",
-			apiListFn: func() (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -46,7 +47,7 @@ func Test_list(t *testing.T) {
 		},
 		{
 			name: "Handles a JSON parsing error",
-			apiListFn: func() (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
 				return mockAPIBadJSON, nil
 			},
 			want:       nil,
@@ -55,7 +56,7 @@ func Test_list(t *testing.T) {
 		},
 		{
 			name: "Returns a list of user groups",
-			apiListFn: func() (json.RawMessage, error) {
+			apiListFn: func(ctx context.Context) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockList,
@@ -65,7 +66,7 @@ func Test_list(t *testing.T) {
 	for _, tt := range tests {
 		apiUserGroupList = tt.apiListFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := list()
+			got, err := list(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("list() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -99,8 +100,8 @@ func Test_get(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		findFn     func(id string) (*api.UserGroup, error)
-		apiGetFn   func(id string) (json.RawMessage, error)
+		findFn     func(ctx context.Context, id string) (*api.UserGroup, error)
+		apiGetFn   func(ctx context.Context, id string) (json.RawMessage, error)
 		want       *api.UserGroup
 		wantErr    bool
 		wantErrMsg string
@@ -110,7 +111,7 @@ func Test_get(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			apiGetFn: func(test string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, test string) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -122,7 +123,7 @@ func Test_get(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			apiGetFn: func(test string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, test string) (json.RawMessage, error) {
 				return mockBadAPIResponse, nil
 			},
 			want:       nil,
@@ -134,7 +135,7 @@ func Test_get(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			apiGetFn: func(userID string) (json.RawMessage, error) {
+			apiGetFn: func(ctx context.Context, userID string) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    &mockUserGroup,
@@ -144,7 +145,7 @@ func Test_get(t *testing.T) {
 	for _, tt := range tests {
 		apiUserGroupGet = tt.apiGetFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := get(tt.args.id)
+			got, err := get(context.Background(), tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -180,7 +181,7 @@ func TestCreate(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		apiCreateFn func(u *api.UserGroup) (json.RawMessage, error)
+		apiCreateFn func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error)
 		want        []byte
 		wantErr     bool
 		wantErrMsg  string
@@ -191,7 +192,7 @@ func TestCreate(t *testing.T) {
 				name: "Test Group",
 				fs:   GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.UserGroup) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error) {
 				return nil, errors.New("Tried to create a team without users")
 			},
 			want:       nil,
@@ -204,7 +205,7 @@ func TestCreate(t *testing.T) {
 				name: "Team 1",
 				fs:   GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.UserGroup) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error) {
 				return mockBadAPIResponse, nil
 			},
 			want:       nil,
@@ -217,7 +218,7 @@ func TestCreate(t *testing.T) {
 				name: "Test Group",
 				fs:   GetWriterFlags(),
 			},
-			apiCreateFn: func(u *api.UserGroup) (json.RawMessage, error) {
+			apiCreateFn: func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error) {
 				return mockAPIResponse, nil
 			},
 			want:    mockMonitorGroupJSON,
@@ -227,7 +228,7 @@ func TestCreate(t *testing.T) {
 	for _, tt := range tests {
 		apiUserGroupCreate = tt.apiCreateFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Create(tt.args.name, tt.args.fs)
+			got, err := Create(context.Background(), tt.args.name, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -253,7 +254,7 @@ func TestGet(t *testing.T) {
 	tests := []struct {
 		name       string
 		args       args
-		getFn      func(id string) (*api.UserGroup, error)
+		getFn      func(ctx context.Context, id string) (*api.UserGroup, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
@@ -263,7 +264,7 @@ func TestGet(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			getFn: func(id string) (*api.UserGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.UserGroup, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -275,7 +276,7 @@ func TestGet(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			getFn: func(id string) (*api.UserGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.UserGroup, error) {
 				return mockUserGroup, nil
 			},
 			want:    mockUserGroupJSON,
@@ -285,7 +286,7 @@ func TestGet(t *testing.T) {
 	for _, tt := range tests {
 		get = tt.getFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := Get(tt.args.id)
+			got, err := Get(context.Background(), tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -306,6 +307,11 @@ func TestUpdate(t *testing.T) {
 		fs *pflag.FlagSet
 	}
 
+	// User names are resolved elsewhere; pass them through
+	resolve := resolveUsers
+	resolveUsers = func(ctx context.Context, refs []string) ([]string, error) { return refs, nil }
+	t.Cleanup(func() { resolveUsers = resolve })
+
 	fs := GetWriterFlags()
 
 	mockGroup := &api.UserGroup{ID: "1001001SOS", Name: "Test Group"}
@@ -322,8 +328,8 @@ func TestUpdate(t *testing.T) {
 		name             string
 		args             args
 		before           func()
-		getFn            func(id string) (*api.UserGroup, error)
-		apiGroupUpdateFn func(u *api.UserGroup) (json.RawMessage, error)
+		getFn            func(ctx context.Context, id string) (*api.UserGroup, error)
+		apiGroupUpdateFn func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error)
 		want             []byte
 		wantErr          bool
 		wantErrMsg       string
@@ -337,7 +343,7 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string) (*api.UserGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.UserGroup, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -353,10 +359,10 @@ func TestUpdate(t *testing.T) {
 			before: func() {
 				// noop
 			},
-			getFn: func(id string) (*api.UserGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.UserGroup, error) {
 				return mockGroup, nil
 			},
-			apiGroupUpdateFn: func(u *api.UserGroup) (json.RawMessage, error) {
+			apiGroupUpdateFn: func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -377,10 +383,10 @@ func TestUpdate(t *testing.T) {
 				// this flag should be ignored
 				fs.Set("ignore-me", "boo!")
 			},
-			getFn: func(id string) (*api.UserGroup, error) {
+			getFn: func(ctx context.Context, id string) (*api.UserGroup, error) {
 				return mockGroup, nil
 			},
-			apiGroupUpdateFn: func(u *api.UserGroup) (json.RawMessage, error) {
+			apiGroupUpdateFn: func(ctx context.Context, u *api.UserGroup) (json.RawMessage, error) {
 				// return what was sent
 				j, _ := json.Marshal(u)
 
@@ -395,7 +401,7 @@ func TestUpdate(t *testing.T) {
 		apiUserGroupUpdate = tt.apiGroupUpdateFn
 		t.Run(tt.name, func(t *testing.T) {
 			tt.before()
-			got, err := Update(tt.args.id, tt.args.fs)
+			got, err := Update(context.Background(), tt.args.id, tt.args.fs)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -417,7 +423,7 @@ func TestDelete(t *testing.T) {
 	tests := []struct {
 		name        string
 		args        args
-		apiDeleteFn func(id string) error
+		apiDeleteFn func(ctx context.Context, id string) error
 		wantErr     bool
 		wantErrMsg  string
 	}{
@@ -426,7 +432,7 @@ func TestDelete(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return errors.New("testing")
 			},
 			wantErr:    true,
@@ -437,7 +443,7 @@ func TestDelete(t *testing.T) {
 			args: args{
 				id: "1001001SOS",
 			},
-			apiDeleteFn: func(id string) error {
+			apiDeleteFn: func(ctx context.Context, id string) error {
 				return nil
 			},
 			wantErr: false,
@@ -446,7 +452,7 @@ func TestDelete(t *testing.T) {
 	for _, tt := range tests {
 		apiUserGroupDelete = tt.apiDeleteFn
 		t.Run(tt.name, func(t *testing.T) {
-			err := Delete(tt.args.id)
+			err := Delete(context.Background(), tt.args.id)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -467,14 +473,14 @@ func TestList(t *testing.T) {
 
 	tests := []struct {
 		name       string
-		listFn     func() ([]api.UserGroup, error)
+		listFn     func(ctx context.Context) ([]api.UserGroup, error)
 		want       []byte
 		wantErr    bool
 		wantErrMsg string
 	}{
 		{
 			name: "Handles an error from the list function",
-			listFn: func() ([]api.UserGroup, error) {
+			listFn: func(ctx context.Context) ([]api.UserGroup, error) {
 				return nil, errors.New("testing")
 			},
 			want:       nil,
@@ -483,7 +489,7 @@ func TestList(t *testing.T) {
 		},
 		{
 			name: "Returns a list of users",
-			listFn: func() ([]api.UserGroup, error) {
+			listFn: func(ctx context.Context) ([]api.UserGroup, error) {
 				return mockList, nil
 			},
 			want:    mockJSON,
@@ -493,7 +499,7 @@ func TestList(t *testing.T) {
 	for _, tt := range tests {
 		list = tt.listFn
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := List()
+			got, err := List(context.Background())
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -0,0 +1,66 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl/location"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// locationCmd represents the `location` command
+var locationCmd = &cobra.Command{
+	Use:   "location <command>",
+	Short: "Performs polling location actions",
+	Long: `Performs polling location actions.
+
+https://www.site24x7.com/help/api/#location-template`,
+	Aliases: []string{"loc"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any location command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// locationListCmd represents the `location list` subcommand
+var locationListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all available polling locations",
+	Long: `Retrieves a list of all available polling locations. A location's name,
+city or ID may be used wherever a location is expected, e.g.
+
+  site24x7 location_profile create "Europe" --primary-location London --secondary-locations Paris,Frankfurt`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := location.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, location.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(locationCmd)
+	locationCmd.AddCommand(locationListCmd)
+}
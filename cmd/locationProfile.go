@@ -0,0 +1,191 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/locationprofile"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// locationProfileCmd represents the `location_profile` command
+var locationProfileCmd = &cobra.Command{
+	Use:   "location_profile <command>",
+	Short: "Performs location profile actions",
+	Long: `Performs location profile actions. A location profile determines the
+locations from which a monitor is polled; locations may be given by name or ID.
+
+https://www.site24x7.com/help/api/#location-profiles`,
+	Aliases: []string{"lp", "locationprofile"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any location_profile command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// locationProfileCreateCmd represents the `location_profile create` subcommand
+var locationProfileCreateCmd = &cobra.Command{
+	Use:   "create <profile name>",
+	Short: "Creates a new location profile",
+	Long: `Creates a new location profile.
+
+https://www.site24x7.com/help/api/#create-location-profile`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := locationprofile.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, locationprofile.Table)
+	},
+}
+
+// locationProfileGetCmd represents the `location_profile get` subcommand
+var locationProfileGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific location profile",
+	Long: `Retrieves a specific location profile.
+
+https://www.site24x7.com/help/api/#retrieve-location-profile`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		j, err := locationprofile.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, locationprofile.Table)
+	},
+}
+
+// locationProfileUpdateCmd represents the `location_profile update` subcommand
+var locationProfileUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing location profile",
+	Long: `Updates an existing location profile.
+
+https://www.site24x7.com/help/api/#update-location-profile`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		json, err := locationprofile.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, locationprofile.Table)
+	},
+}
+
+// locationProfileDeleteCmd represents the `location_profile delete` subcommand
+var locationProfileDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific location profile",
+	Long: `Deletes a specific location profile.
+
+https://www.site24x7.com/help/api/#delete-location-profile`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := locationprofile.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Location profile", "deleted")
+
+		return nil
+	},
+}
+
+// locationProfileListCmd represents the `location_profile list` subcommand
+var locationProfileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all location profiles",
+	Long: `Retrieves a list of all location profiles.
+
+https://www.site24x7.com/help/api/#list-all-location-profiles`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := locationprofile.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, locationprofile.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(locationProfileCmd)
+	locationProfileCmd.AddCommand(locationProfileCreateCmd)
+	locationProfileCmd.AddCommand(locationProfileGetCmd)
+	locationProfileCmd.AddCommand(locationProfileUpdateCmd)
+	locationProfileCmd.AddCommand(locationProfileDeleteCmd)
+	locationProfileCmd.AddCommand(locationProfileListCmd)
+
+	// Flags for the `location_profile create` command
+	locationProfileCreateCmd.Flags().AddFlagSet(locationprofile.GetWriterFlags())
+	locationProfileCreateCmd.MarkFlagRequired("primary-location")
+
+	// Flags for the `location_profile update` command
+	locationProfileUpdateCmd.Flags().AddFlagSet(locationprofile.GetWriterFlags())
+}
@@ -0,0 +1,195 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/maintenance"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// maintenanceCmd represents the `maintenance` command
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance <command>",
+	Short: "Performs maintenance window actions",
+	Long: `Performs scheduled maintenance window actions. Alerts are suppressed for
+the monitors, monitor groups or tags that a window targets while it's in effect.
+
+https://www.site24x7.com/help/api/#schedule-maintenances`,
+	Aliases: []string{"mw", "maint"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any maintenance command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// maintenanceCreateCmd represents the `maintenance create` subcommand
+var maintenanceCreateCmd = &cobra.Command{
+	Use:   "create <display name>",
+	Short: "Creates a new maintenance window",
+	Long: `Creates a new one-time or recurring maintenance window, e.g.
+
+  site24x7 maintenance create "Deploy" --monitor-groups 123 --start "2021-06-01 22:00" --end "2021-06-01 23:30" --timezone Europe/London
+  site24x7 maintenance create "Backups" --type weekly --days sat,sun --tags 456 --start 2021-06-05T02:00:00Z --end 2021-06-05T03:00:00Z --until 2021-12-31
+
+https://www.site24x7.com/help/api/#create-one-time-maintenance`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := maintenance.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, maintenance.Table)
+	},
+}
+
+// maintenanceGetCmd represents the `maintenance get` subcommand
+var maintenanceGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific maintenance window",
+	Long: `Retrieves a specific maintenance window.
+
+https://www.site24x7.com/help/api/#retrieve-maintenance`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		j, err := maintenance.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, maintenance.Table)
+	},
+}
+
+// maintenanceUpdateCmd represents the `maintenance update` subcommand
+var maintenanceUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing maintenance window",
+	Long: `Updates an existing maintenance window.
+
+https://www.site24x7.com/help/api/#update-maintenance`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		json, err := maintenance.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, maintenance.Table)
+	},
+}
+
+// maintenanceDeleteCmd represents the `maintenance delete` subcommand
+var maintenanceDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific maintenance window",
+	Long: `Deletes a specific maintenance window.
+
+https://www.site24x7.com/help/api/#delete-maintenance`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := maintenance.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Maintenance window", "deleted")
+
+		return nil
+	},
+}
+
+// maintenanceListCmd represents the `maintenance list` subcommand
+var maintenanceListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all maintenance windows",
+	Long: `Retrieves a list of all maintenance windows.
+
+https://www.site24x7.com/help/api/#list-of-all-maintenance`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := maintenance.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, maintenance.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(maintenanceCmd)
+	maintenanceCmd.AddCommand(maintenanceCreateCmd)
+	maintenanceCmd.AddCommand(maintenanceGetCmd)
+	maintenanceCmd.AddCommand(maintenanceUpdateCmd)
+	maintenanceCmd.AddCommand(maintenanceDeleteCmd)
+	maintenanceCmd.AddCommand(maintenanceListCmd)
+
+	// Flags for the `maintenance create` command
+	maintenanceCreateCmd.Flags().AddFlagSet(maintenance.GetWriterFlags())
+	maintenanceCreateCmd.MarkFlagRequired("start")
+	maintenanceCreateCmd.MarkFlagRequired("end")
+
+	// Flags for the `maintenance update` command
+	maintenanceUpdateCmd.Flags().AddFlagSet(maintenance.GetWriterFlags())
+}
@@ -0,0 +1,126 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/milestone"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// milestoneCmd represents the `milestone` command
+var milestoneCmd = &cobra.Command{
+	Use:   "milestone <command>",
+	Short: "Performs milestone marker actions",
+	Long: `Performs milestone marker actions. A milestone, e.g. a release, is marked on
+the graphs of the monitors or monitor groups that it targets.
+
+https://www.site24x7.com/help/api/#milestone-marker`,
+	Aliases: []string{"milestones"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any milestone command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// milestoneAddCmd represents the `milestone add` subcommand
+var milestoneAddCmd = &cobra.Command{
+	Use:   "add",
+	Short: "Marks a milestone",
+	Long: `Marks a milestone on the graphs of monitors or monitor groups, by name or
+ID, e.g. from a deploy pipeline
+
+  site24x7 milestone add --name "deploy v1.2.3" --group Production
+  site24x7 milestone add --name "deploy v1.2.3" --monitors "Checkout API","Basket API" --time 15m
+
+https://www.site24x7.com/help/api/#add-a-milestone-marker`,
+	Aliases: []string{"create", "mark"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := milestone.Add(cmd.Context(), cmd.Flags()); err != nil {
+			return err
+		}
+
+		success("Milestone", "added")
+
+		return nil
+	},
+}
+
+// milestoneDeleteCmd represents the `milestone delete` subcommand
+var milestoneDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific milestone",
+	Long: `Deletes a specific milestone.
+
+https://www.site24x7.com/help/api/#delete-milestone-marker`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := milestone.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Milestone", "deleted")
+
+		return nil
+	},
+}
+
+// milestoneListCmd represents the `milestone list` subcommand
+var milestoneListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all milestones",
+	Long: `Retrieves a list of all milestones.
+
+https://www.site24x7.com/help/api/#list-milestone-markers`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := milestone.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, milestone.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(milestoneCmd)
+	milestoneCmd.AddCommand(milestoneAddCmd)
+	milestoneCmd.AddCommand(milestoneDeleteCmd)
+	milestoneCmd.AddCommand(milestoneListCmd)
+
+	// Flags for the `milestone add` command
+	milestoneAddCmd.Flags().AddFlagSet(milestone.GetWriterFlags())
+	milestoneAddCmd.MarkFlagRequired("name")
+}
@@ -0,0 +1,265 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/monitor"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/tag"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// monitorCmd represents the `monitor` command
+var monitorCmd = &cobra.Command{
+	Use:   "monitor <command>",
+	Short: "Performs monitor actions",
+	Long: `Performs monitor actions.
+
+Website (URL), REST API (RESTAPI), ping (PING), port (PORT), DNS (DNS) and SSL
+certificate (SSL_CERT) monitors are supported.
+
+https://www.site24x7.com/help/api/#monitors`,
+	Aliases: []string{"mon"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any monitor command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// monitorCreateCmd represents the `monitor create` subcommand
+var monitorCreateCmd = &cobra.Command{
+	Use:   "create <display name>",
+	Short: "Creates a new monitor",
+	Long: `Creates a new monitor.
+
+Each monitor type requires its own flags:
+  URL, RESTAPI  --url
+  PING          --host
+  PORT          --host, --port
+  DNS           --dns-server, --domain
+  SSL_CERT      --domain
+
+https://www.site24x7.com/help/api/#create-monitor`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := monitor.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, monitor.Table)
+	},
+}
+
+// monitorGetCmd represents the `monitor get` subcommand
+var monitorGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific monitor",
+	Long: `Retrieves a specific monitor.
+
+https://www.site24x7.com/help/api/#retrieve-monitor`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		j, err := monitor.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, monitor.Table)
+	},
+}
+
+// monitorUpdateCmd represents the `monitor update` subcommand
+var monitorUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing monitor",
+	Long: `Updates an existing monitor.
+
+https://www.site24x7.com/help/api/#update-monitor`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		json, err := monitor.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, monitor.Table)
+	},
+}
+
+// monitorDeleteCmd represents the `monitor delete` subcommand
+var monitorDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific monitor",
+	Long: `Deletes a specific monitor.
+
+https://www.site24x7.com/help/api/#delete-monitor`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := monitor.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Monitor", "deleted")
+
+		return nil
+	},
+}
+
+// monitorActivateCmd represents the `monitor activate` subcommand
+var monitorActivateCmd = &cobra.Command{
+	Use:   "activate <id>",
+	Short: "Resumes monitoring for a suspended monitor",
+	Long: `Resumes monitoring for a suspended monitor.
+
+https://www.site24x7.com/help/api/#activate-monitor`,
+	Aliases: []string{"resume", "enable"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := monitor.Activate(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Monitor", "activated")
+
+		return nil
+	},
+}
+
+// monitorSuspendCmd represents the `monitor suspend` subcommand
+var monitorSuspendCmd = &cobra.Command{
+	Use:   "suspend <id>",
+	Short: "Suspends monitoring for a monitor",
+	Long: `Suspends monitoring for a monitor.
+
+https://www.site24x7.com/help/api/#suspend-monitor`,
+	Aliases: []string{"pause", "disable"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := monitor.Suspend(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Monitor", "suspended")
+
+		return nil
+	},
+}
+
+// monitorListCmd represents the `monitor list` subcommand
+var monitorListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all monitors",
+	Long: `Retrieves a list of all monitors.
+
+https://www.site24x7.com/help/api/#list-of-all-monitors`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := monitor.List(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, monitor.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(monitorCmd)
+	monitorCmd.AddCommand(monitorCreateCmd)
+	monitorCmd.AddCommand(monitorGetCmd)
+	monitorCmd.AddCommand(monitorUpdateCmd)
+	monitorCmd.AddCommand(monitorDeleteCmd)
+	monitorCmd.AddCommand(monitorActivateCmd)
+	monitorCmd.AddCommand(monitorSuspendCmd)
+	monitorCmd.AddCommand(monitorListCmd)
+
+	// Flags for the `monitor create` command
+	monitorCreateCmd.Flags().AddFlagSet(monitor.GetWriterFlags())
+	monitorCreateCmd.MarkFlagRequired("type")
+
+	// Flags for the `monitor update` command
+	monitorUpdateCmd.Flags().AddFlagSet(monitor.GetWriterFlags())
+
+	// Flags for the `monitor list` command
+	monitorListCmd.Flags().StringP("type", "t", "", "Only list monitors of a given type, e.g. URL")
+	monitorListCmd.Flags().AddFlagSet(tag.GetSelectorFlags())
+}
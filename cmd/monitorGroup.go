@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"site24x7/api"
 	"site24x7/cmd/impl/monitorgroup"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/tag"
 	"site24x7/logger"
 
 	"github.com/spf13/cobra"
@@ -27,15 +29,16 @@ import (
 
 // monitorGroupCmd represents the monitorGroup command
 var monitorGroupCmd = &cobra.Command{
-	Use:     "monitor_group <command>",
-	Short:   "Performs monitor group actions",
-	Long:    `Performs monitor group actions.`,
+	Use:   "monitor_group <command>",
+	Short: "Performs monitor group actions",
+	Long: `Performs monitor group actions. Wherever a monitor group ID is expected,
+the group's display name may be given instead.`,
 	Aliases: []string{"mg", "mongroup", "mgroup", "mongru"},
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		// authenticate before all non-config commands
-		api.Authenticate()
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		// set the log verbosity for any monitor_group command execution
 		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
 	},
 	// Run: func(cmd *cobra.Command, args []string) {
 	//  NOOP - requires subcommand
@@ -59,26 +62,18 @@ var monitorGroupCreateCmd = &cobra.Command{
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name := args[0]
-		json, err := monitorgroup.Create(name, cmd.Flags())
+		json, err := monitorgroup.Create(cmd.Context(), name, cmd.LocalFlags())
 		if err != nil {
-			// Handle a user already exists error nicely
-			if err, ok := err.(*api.ConflictError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, monitorgroup.Table)
 	},
 }
 
 // userGetCmd represents the `monitor_group get` subcommand
 var monitorGroupGetCmd = &cobra.Command{
-	Use:     "get <id>",
+	Use:     "get <id|name>",
 	Short:   "Retrieves a specific monitor group",
 	Long:    `Retrieves a specific monitor group.`,
 	Aliases: []string{"fetch", "retrieve", "read"},
@@ -92,50 +87,41 @@ var monitorGroupGetCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-		j, err := monitorgroup.Get(id, cmd.Flags())
+		id, err := monitorgroup.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		j, err := monitorgroup.Get(cmd.Context(), id, cmd.Flags())
 		if err != nil {
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(j))
-
-		return nil
+		return output.Render(cmd.Flags(), j, monitorgroup.Table)
 	},
 }
 
 var monitorGroupUpdateCmd = &cobra.Command{
-	Use:     "update <id>",
+	Use:     "update <id|name>",
 	Short:   "Updates an existing monitor group",
 	Long:    `Updates an existing monitor group.`,
 	Aliases: []string{"modify"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-		json, err := monitorgroup.Update(id, cmd.Flags())
+		id, err := monitorgroup.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		json, err := monitorgroup.Update(cmd.Context(), id, cmd.LocalFlags())
 		if err != nil {
-			// Handle a known error just a bit more cleanly
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, monitorgroup.Table)
 	},
 }
 
 // monitorGroupDeleteCmd represents the `monitor_group delete` subcommand
 var monitorGroupDeleteCmd = &cobra.Command{
-	Use:     "delete <id>",
+	Use:     "delete <id|name>",
 	Short:   "Deletes a specific monitor group",
 	Long:    `Deletes a specific monitor group.`,
 	Aliases: []string{"del", "rm", "remove"},
@@ -149,14 +135,16 @@ var monitorGroupDeleteCmd = &cobra.Command{
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-
-		err := monitorgroup.Delete(id, cmd.Flags())
+		id, err := monitorgroup.Resolve(cmd.Context(), args[0])
 		if err != nil {
 			return err
 		}
 
-		logger.Out("Monitor group successfully deleted!")
+		if err := monitorgroup.Delete(cmd.Context(), id, cmd.Flags()); err != nil {
+			return err
+		}
+
+		success("Monitor group", "deleted")
 
 		return nil
 	},
@@ -169,14 +157,12 @@ var monitorGroupListCmd = &cobra.Command{
 	Long:    `Retrieves a list of all monitor groups.`,
 	Aliases: []string{"ls"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		json, err := monitorgroup.List(cmd.Flags())
+		json, err := monitorgroup.List(cmd.Context(), cmd.Flags())
 		if err != nil {
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, monitorgroup.Table)
 	},
 }
 
@@ -194,6 +180,7 @@ func init() {
 
 	// Flags for the `monitor_group list command`
 	monitorGroupListCmd.Flags().Bool("with-subgroups", false, "When true, returns all subgroups")
+	monitorGroupListCmd.Flags().AddFlagSet(tag.GetSelectorFlags())
 
 	// Flags for the `monitor_group update` command
 	monitorGroupUpdateCmd.Flags().AddFlagSet(monitorgroup.GetWriterFlags())
@@ -0,0 +1,190 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/notificationprofile"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// notificationProfileCmd represents the `notification_profile` command
+var notificationProfileCmd = &cobra.Command{
+	Use:   "notification_profile <command>",
+	Short: "Performs notification profile actions",
+	Long: `Performs notification profile actions. A notification profile determines
+who is alerted about a monitor's downtime, when, and how alerts are escalated.
+
+https://www.site24x7.com/help/api/#notification-profiles`,
+	Aliases: []string{"np", "notificationprofile", "notification"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any notification_profile command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// notificationProfileCreateCmd represents the `notification_profile create` subcommand
+var notificationProfileCreateCmd = &cobra.Command{
+	Use:   "create <profile name>",
+	Short: "Creates a new notification profile",
+	Long: `Creates a new notification profile.
+
+https://www.site24x7.com/help/api/#create-notification-profile`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := notificationprofile.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, notificationprofile.Table)
+	},
+}
+
+// notificationProfileGetCmd represents the `notification_profile get` subcommand
+var notificationProfileGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific notification profile",
+	Long: `Retrieves a specific notification profile.
+
+https://www.site24x7.com/help/api/#retrieve-notification-profile`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		j, err := notificationprofile.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, notificationprofile.Table)
+	},
+}
+
+// notificationProfileUpdateCmd represents the `notification_profile update` subcommand
+var notificationProfileUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing notification profile",
+	Long: `Updates an existing notification profile.
+
+https://www.site24x7.com/help/api/#update-notification-profile`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		json, err := notificationprofile.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, notificationprofile.Table)
+	},
+}
+
+// notificationProfileDeleteCmd represents the `notification_profile delete` subcommand
+var notificationProfileDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific notification profile",
+	Long: `Deletes a specific notification profile.
+
+https://www.site24x7.com/help/api/#delete-notification-profile`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := notificationprofile.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Notification profile", "deleted")
+
+		return nil
+	},
+}
+
+// notificationProfileListCmd represents the `notification_profile list` subcommand
+var notificationProfileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all notification profiles",
+	Long: `Retrieves a list of all notification profiles.
+
+https://www.site24x7.com/help/api/#list-all-notification-profiles`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := notificationprofile.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, notificationprofile.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(notificationProfileCmd)
+	notificationProfileCmd.AddCommand(notificationProfileCreateCmd)
+	notificationProfileCmd.AddCommand(notificationProfileGetCmd)
+	notificationProfileCmd.AddCommand(notificationProfileUpdateCmd)
+	notificationProfileCmd.AddCommand(notificationProfileDeleteCmd)
+	notificationProfileCmd.AddCommand(notificationProfileListCmd)
+
+	// Flags for the `notification_profile create` command
+	notificationProfileCreateCmd.Flags().AddFlagSet(notificationprofile.GetWriterFlags())
+
+	// Flags for the `notification_profile update` command
+	notificationProfileUpdateCmd.Flags().AddFlagSet(notificationprofile.GetWriterFlags())
+}
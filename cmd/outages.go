@@ -0,0 +1,74 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/outage"
+	"site24x7/cmd/impl/output"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// outagesCmd represents the `outages` command
+var outagesCmd = &cobra.Command{
+	Use:   "outages",
+	Short: "Retrieves the outages of monitors over a period",
+	Long: `Retrieves the outages of monitors over a period as a timeline, oldest first,
+with the duration and reason of each. Narrow it to a monitor or a monitor
+group, by name or ID, e.g.
+
+  site24x7 outages --group Production --period last-7-days
+  site24x7 outages --monitor "Checkout API" --since 3d -o json
+
+Machine-readable output includes each outage's duration in seconds
+(duration_seconds) and whether it's ongoing.
+
+https://www.site24x7.com/help/api/#outage-report`,
+	Aliases: []string{"outage"},
+	Args:    cobra.NoArgs,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any outages command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, summary, err := outage.List(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if err := output.Render(cmd.Flags(), json, outage.Table); err != nil {
+			return err
+		}
+		if format, _ := cmd.Flags().GetString("output"); format == output.DefaultFormat {
+			logger.Out("\n" + summary)
+		}
+
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(outagesCmd)
+
+	outagesCmd.Flags().String("monitor", "", "Only list the outages of a monitor, by name or ID")
+	outagesCmd.Flags().String("group", "", "Only list the outages of the monitors of a monitor group, by name or ID")
+	outagesCmd.Flags().AddFlagSet(impl.GetPeriodFlags("last-7-days"))
+}
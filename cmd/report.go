@@ -0,0 +1,132 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"site24x7/api"
+	"site24x7/cmd/impl"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/report"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// reportCmd represents the `report` command
+var reportCmd = &cobra.Command{
+	Use:   "report <command>",
+	Short: "Reports on the availability and performance of monitors",
+	Long: `Reports on the availability and performance of a monitor, or of each monitor
+in a monitor group, over a period. Each monitor is a row, so -o csv can be
+dropped straight into a spreadsheet, e.g.
+
+  site24x7 report sla --group Production --period last-month --target 99.95 -o csv > sla.csv`,
+	Aliases: []string{"reports"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any report command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// reportAvailabilityCmd represents the `report availability` subcommand
+var reportAvailabilityCmd = &cobra.Command{
+	Use:   "availability",
+	Short: "Summarizes the availability of monitors",
+	Long: `Summarizes the availability of monitors: the percentage of the period that
+each was available, down and in maintenance, and how often it went down.
+
+https://www.site24x7.com/help/api/#availability-summary-report`,
+	Aliases: []string{"avail", "uptime"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := report.Availability(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, report.AvailabilityTable)
+	},
+}
+
+// reportPerformanceCmd represents the `report performance` subcommand
+var reportPerformanceCmd = &cobra.Command{
+	Use:   "performance",
+	Short: "Summarizes the response times of monitors",
+	Long: `Summarizes the response times of monitors: the average, minimum, maximum and
+95th percentile over the period.
+
+https://www.site24x7.com/help/api/#performance-report`,
+	Aliases: []string{"perf"},
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := report.Performance(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, report.PerformanceTable)
+	},
+}
+
+// reportSLACmd represents the `report sla` subcommand
+var reportSLACmd = &cobra.Command{
+	Use:   "sla",
+	Short: "Reports whether monitors met an availability target",
+	Long: `Reports whether the availability of monitors met a --target percentage over
+the period, with the downtime that the target allowed and the downtime that
+each monitor had, in seconds.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, summary, err := report.SLA(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+		if err := output.Render(cmd.Flags(), json, report.SLATable); err != nil {
+			return err
+		}
+		if format, _ := cmd.Flags().GetString("output"); format == output.DefaultFormat {
+			logger.Out("\n" + summary)
+		}
+
+		return nil
+	},
+}
+
+// reportFlags returns the flags that choose what a report covers
+func reportFlags(defaultPeriod string) *pflag.FlagSet {
+	fs := pflag.NewFlagSet("report", pflag.ContinueOnError)
+	fs.String("monitor", "", "Report on a monitor, by name or ID")
+	fs.String("group", "", "Report on each monitor of a monitor group, by name or ID")
+	fs.AddFlagSet(impl.GetPeriodFlags(defaultPeriod))
+
+	return fs
+}
+
+func init() {
+	rootCmd.AddCommand(reportCmd)
+	reportCmd.AddCommand(reportAvailabilityCmd)
+	reportCmd.AddCommand(reportPerformanceCmd)
+	reportCmd.AddCommand(reportSLACmd)
+
+	reportAvailabilityCmd.Flags().AddFlagSet(reportFlags("last-30-days"))
+	reportPerformanceCmd.Flags().AddFlagSet(reportFlags("last-30-days"))
+	reportSLACmd.Flags().AddFlagSet(reportFlags("last-month"))
+	reportSLACmd.Flags().Float64("target", 99.9, "Availability target, as a percentage")
+}
@@ -17,7 +17,20 @@ limitations under the License.
 package cmd
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/exec"
+	"os/signal"
+	"site24x7/api"
+	"site24x7/cmd/impl/manifest"
+	"site24x7/cmd/impl/output"
+	"site24x7/config"
+	"site24x7/logger"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -30,13 +43,119 @@ var cfgFile string
 var rootCmd = &cobra.Command{
 	Use:   "site24x7",
 	Short: "A command line client for Site24x7",
-	Long:  "A command line client for Site24x7.",
+	Long: `A command line client for Site24x7.
+
+Exit codes: 0 on success, 2 when diff finds drift, 3 for an API error, 4 when
+an object isn't found, 5 when it already exists, 6 when the credentials are
+rejected, 7 when they lack permission, 8 when requests are still throttled
+after every retry, 9 when the --deadline passes, 130 when interrupted, and 1
+for anything else. A command run by heartbeat wrap exits with its own code.`,
+	// Execute reports errors, once it knows why a command was cancelled
+	SilenceErrors: true,
 }
 
+// The command's context is cancelled by an interrupt (Ctrl-C) or SIGTERM, or
+// once the --deadline passes, abandoning any API requests in flight
+var (
+	interruptContext context.Context
+	cancelCommand    context.CancelFunc
+	deadlineTimer    *time.Timer
+)
+
+// Exit codes, by the class of error that ended a command
+const (
+	exitError        = 1 // any error not listed below, including misuse
+	exitDrift        = 2 // diff found objects that differ from their manifests
+	exitAPIError     = 3 // an API error of no particular class
+	exitNotFound     = 4
+	exitConflict     = 5
+	exitUnauthorized = 6
+	exitForbidden    = 7
+	exitRateLimited  = 8
+	exitDeadline     = 9
+	exitInterrupted  = 130 // as a shell reports a command killed by SIGINT
+)
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	cobra.CheckErr(rootCmd.Execute())
+	if code := execute(); code != 0 {
+		os.Exit(code)
+	}
+}
+
+// execute runs the command named by the arguments and returns its exit code,
+// having reported any error
+func execute() int {
+	interrupt, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, cancel := context.WithCancel(interrupt)
+	interruptContext, cancelCommand = interrupt, cancel
+
+	err := rootCmd.ExecuteContext(ctx)
+	if err != nil && errors.Is(err, context.Canceled) {
+		if deadlineTimer != nil && !deadlineTimer.Stop() {
+			deadline, _ := rootCmd.PersistentFlags().GetDuration("deadline")
+			err = fmt.Errorf("the --deadline of %s passed: %w", deadline, context.DeadlineExceeded)
+		} else if interrupt.Err() != nil {
+			err = fmt.Errorf("interrupted: %w", err)
+		}
+	}
+	cancel()
+	stop()
+
+	if err != nil {
+		rootCmd.PrintErrln("Error:", err.Error())
+		return exitCode(err)
+	}
+
+	return 0
+}
+
+// interrupted reports whether the command was cancelled by an interrupt or
+// SIGTERM, rather than by the --deadline passing
+func interrupted() bool {
+	return interruptContext != nil && interruptContext.Err() != nil
+}
+
+// exitCode returns the exit code for the class of error that ended a command,
+// so that scripts can tell, e.g., a missing entity from an expired credential
+func exitCode(err error) int {
+	var (
+		notFound     *api.NotFoundError
+		conflict     *api.ConflictError
+		unauthorized *api.UnauthorizedError
+		forbidden    *api.ForbiddenError
+		rateLimited  *api.RateLimitError
+		apiErr       *api.Error
+		exited       *exec.ExitError
+		drift        *manifest.DriftError
+	)
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		return exitDeadline
+	case errors.Is(err, context.Canceled):
+		return exitInterrupted
+	case errors.As(err, &notFound):
+		return exitNotFound
+	case errors.As(err, &conflict):
+		return exitConflict
+	case errors.As(err, &unauthorized):
+		return exitUnauthorized
+	case errors.As(err, &forbidden):
+		return exitForbidden
+	case errors.As(err, &rateLimited):
+		return exitRateLimited
+	case errors.As(err, &apiErr):
+		return exitAPIError
+	case errors.As(err, &drift):
+		return exitDrift
+	case errors.As(err, &exited) && exited.ExitCode() > 0:
+		// a wrapped command's own exit code
+		return exited.ExitCode()
+	}
+
+	return exitError
 }
 
 func init() {
@@ -48,6 +167,13 @@ func init() {
 	// rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.site24x7.yaml)")
 	rootCmd.PersistentFlags().BoolP("quiet", "q", false, "Silences all output; takes precedence over any verbose setting")
 	rootCmd.PersistentFlags().CountP("verbose", "v", "Enable verbose output; supports v, vv, or vvv")
+	rootCmd.PersistentFlags().String("data-center", "", fmt.Sprintf("Site24x7 data center hosting the account: %s (default from config, else %s)", strings.Join(api.DataCenterCodes(), ", "), api.DefaultDataCenter))
+	rootCmd.PersistentFlags().StringP("output", "o", output.DefaultFormat, fmt.Sprintf("Output format: %s", strings.Join(output.Formats, ", ")))
+	rootCmd.PersistentFlags().Bool("dry-run", false, "Show what would be written to the account without writing it")
+	rootCmd.PersistentFlags().Duration("timeout", api.DefaultTimeout, "Time allowed for each attempt at an API request, e.g. 10s or 1m; 0 means no limit")
+	rootCmd.PersistentFlags().Int("retries", api.DefaultRetries, "Number of times to retry an API request that's throttled or fails transiently")
+	rootCmd.PersistentFlags().Duration("deadline", 0, "Time allowed for the whole command, including every request and retry, e.g. 5m; 0 means no limit")
+	rootCmd.PersistentFlags().String("profile", "", "Named configuration profile to use (default from $SITE24X7_PROFILE, else the profile selected by \"config use\")")
 
 	// Cobra also supports local flags, which will only run
 	// when this action is called directly.
@@ -78,4 +204,41 @@ func initConfig() {
 	} else {
 		viper.SafeWriteConfig()
 	}
+
+	// Select the profile: flag, then environment, then `config use`
+	profile, _ := rootCmd.PersistentFlags().GetString("profile")
+	if profile == "" {
+		profile = os.Getenv("SITE24X7_PROFILE")
+	}
+	cobra.CheckErr(config.SetProfile(profile))
+
+	// Flag values apply to this command only; they're never saved
+	if dc, _ := rootCmd.PersistentFlags().GetString("data-center"); dc != "" {
+		config.Override("auth.data_center", dc)
+	}
+
+	dryRun, _ := rootCmd.PersistentFlags().GetBool("dry-run")
+	api.SetDryRun(dryRun)
+
+	timeout, _ := rootCmd.PersistentFlags().GetDuration("timeout")
+	api.SetTimeout(timeout)
+	retries, _ := rootCmd.PersistentFlags().GetInt("retries")
+	api.SetRetries(retries)
+
+	// The command's context already exists, so it's cancelled when the
+	// deadline passes rather than created with one
+	if deadline, _ := rootCmd.PersistentFlags().GetDuration("deadline"); deadline > 0 && cancelCommand != nil {
+		deadlineTimer = time.AfterFunc(deadline, cancelCommand)
+	}
+}
+
+// success reports that an object was written, e.g. success("User", "deleted"),
+// unless it wasn't because this is a dry run
+func success(object string, action string) {
+	if api.DryRun() {
+		logger.Out(fmt.Sprintf("%s would be %s (dry run)", object, action))
+		return
+	}
+
+	logger.Out(fmt.Sprintf("%s successfully %s!", object, action))
 }
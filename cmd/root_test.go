@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"site24x7/api/mock"
+	"strings"
+	"testing"
+)
+
+// stdout returns what fn writes to stdout, where commands write their output
+func stdout(t *testing.T, fn func()) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := os.Stdout
+	os.Stdout = w
+	defer func() { os.Stdout = orig }()
+
+	out := make(chan []byte)
+	go func() {
+		b, _ := io.ReadAll(r)
+		out <- b
+	}()
+
+	fn()
+	w.Close()
+
+	return string(<-out)
+}
+
+func Test_execute(t *testing.T) {
+	s := mock.New()
+	s.Seed()
+	srv := s.Start()
+	t.Cleanup(srv.Close)
+
+	// A configured profile in a home of its own, pointed at the mock server
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(home, ".cache"))
+	t.Setenv("VERBOSITY", "")
+	t.Setenv("SITE24X7_PROFILE", "")
+	t.Setenv("API_BASE_URL", srv.URL+mock.APIPath)
+	t.Setenv("AUTH_BASE_URL", srv.URL)
+	cfg := "profiles:\n  default:\n    auth:\n      client_id: client\n      client_secret: secret\n      data_center: US\n      refresh_token: refresh\n"
+	if err := os.WriteFile(filepath.Join(home, ".site24x7.yaml"), []byte(cfg), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	var stderr bytes.Buffer
+	rootCmd.SetOut(io.Discard)
+	rootCmd.SetErr(&stderr)
+	t.Cleanup(func() {
+		rootCmd.SetOut(nil)
+		rootCmd.SetErr(nil)
+		rootCmd.SetArgs(nil)
+	})
+
+	tests := []struct {
+		name       string
+		args       []string
+		want       []string // email addresses, in order
+		wantCode   int
+		wantErrMsg string
+	}{
+		{
+			name:     "Lists users as json",
+			args:     []string{"user", "list", "-o", "json"},
+			want:     []string{"ada@example.com", "otto@example.com"},
+			wantCode: 0,
+		},
+		{
+			name:       "Exits with the code for a missing object",
+			args:       []string{"user", "get", "--id", "404", "-o", "json"},
+			wantCode:   exitNotFound,
+			wantErrMsg: "Resource not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stderr.Reset()
+			rootCmd.SetArgs(tt.args)
+
+			var code int
+			out := stdout(t, func() { code = execute() })
+			if code != tt.wantCode {
+				t.Fatalf("execute() = %d, want %d; stderr: %s", code, tt.wantCode, stderr.String())
+			}
+			if tt.wantErrMsg != "" && !strings.Contains(stderr.String(), tt.wantErrMsg) {
+				t.Errorf("execute() stderr = %s, wantErrMsg \"%s\"", stderr.String(), tt.wantErrMsg)
+			}
+			if tt.want == nil {
+				return
+			}
+
+			var users []struct {
+				EmailAddress string `json:"email_address"`
+			}
+			if err := json.Unmarshal([]byte(out), &users); err != nil {
+				t.Fatalf("execute() output isn't json (%s): %s", err, out)
+			}
+			var got []string
+			for _, u := range users {
+				got = append(got, u.EmailAddress)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("execute() listed %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
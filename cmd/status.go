@@ -0,0 +1,139 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/status"
+	"site24x7/logger"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// statusCmd represents the `status` command
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Shows the current status of monitors",
+	Long: `Shows the current status of monitors -- up, down, trouble, maintenance and
+so on -- grouped by monitor group. A monitor that's in more than one group is
+listed under each; one that's in none is listed as ` + status.Ungrouped + `.
+
+Narrow the list with --group (names or IDs), --tag (e.g. env=prod, or IDs) and --state, e.g.
+
+  site24x7 status --state down,trouble --group Production
+
+With --watch, the status is refreshed every --interval until interrupted, and
+monitors whose status has changed since the last refresh are highlighted. A
+refresh that fails is warned about and tried again at the next interval.
+
+https://www.site24x7.com/help/api/#current-status`,
+	Aliases: []string{"st"},
+	Args:    cobra.NoArgs,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any status command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		format, _ := cmd.Flags().GetString("output")
+		table := format == output.DefaultFormat
+
+		if watch, _ := cmd.Flags().GetBool("watch"); !watch {
+			json, summary, err := status.Get(cmd.Context(), cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if err := output.Render(cmd.Flags(), json, status.Table); err != nil {
+				return err
+			}
+			if table {
+				logger.Out("\n" + summary)
+			}
+
+			return nil
+		}
+
+		interval, _ := cmd.Flags().GetDuration("interval")
+		if interval <= 0 {
+			return fmt.Errorf("the --interval must be positive")
+		}
+		cmd.SilenceUsage = true
+
+		color := output.Color()
+		var w status.Watcher
+		for refreshed := false; ; {
+			json, summary, err := w.Next(cmd.Context(), cmd.Flags())
+			if cmd.Context().Err() != nil {
+				return watchEnded(cmd.Context())
+			}
+
+			switch {
+			case err != nil && !refreshed:
+				// Nothing has been shown, so there's nothing to keep watching
+				return err
+			case err != nil:
+				// A refresh that fails, e.g. during an outage, leaves the last
+				// status on screen until the next one
+				logger.Warn(fmt.Sprintf("Unable to refresh the status; retrying in %s (%s)", interval, err))
+			default:
+				refreshed = true
+				if table && color {
+					// Redraw in place, as watch(1) does
+					fmt.Print("\033[H\033[2J")
+				}
+				if err := output.Render(cmd.Flags(), json, status.WatchTable(color)); err != nil {
+					return err
+				}
+				if table {
+					logger.Out(fmt.Sprintf("\n%s (as of %s; refreshing every %s)", summary, time.Now().Format("15:04:05"), interval))
+				}
+			}
+
+			select {
+			case <-cmd.Context().Done():
+				return watchEnded(cmd.Context())
+			case <-time.After(interval):
+			}
+		}
+	},
+}
+
+// watchEnded returns the reason that watching the status stopped. Being
+// interrupted is how watching is meant to stop, but the --deadline passing is
+// still an error.
+func watchEnded(ctx context.Context) error {
+	if interrupted() {
+		return nil
+	}
+
+	return ctx.Err()
+}
+
+func init() {
+	rootCmd.AddCommand(statusCmd)
+
+	statusCmd.Flags().StringSlice("group", nil, "Only show monitors in the given monitor groups, by name or ID")
+	statusCmd.Flags().StringSlice("tag", nil, "Only show monitors with any of the given tags, e.g. env=prod, or tag IDs")
+	statusCmd.Flags().StringSlice("state", nil, "Only show monitors in the given states, e.g. down,trouble")
+	statusCmd.Flags().BoolP("watch", "w", false, "Refresh the status until interrupted, highlighting changes")
+	statusCmd.Flags().Duration("interval", 30*time.Second, "Time between refreshes when watching")
+}
@@ -0,0 +1,209 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/tag"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// tagCmd represents the `tag` command
+var tagCmd = &cobra.Command{
+	Use:   "tag <command>",
+	Short: "Performs tag actions",
+	Long: `Performs tag actions. A tag is a name and, optionally, a value, e.g.
+env=prod, attached to monitors and monitor groups so that they can be selected
+as a set. Wherever a tag ID is expected, the tag may be given as env=prod (or
+env:prod) instead.
+
+https://www.site24x7.com/help/api/#tags`,
+	Aliases: []string{"tags"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any tag command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// tagCreateCmd represents the `tag create` subcommand
+var tagCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Creates a new tag",
+	Long: `Creates a new tag, e.g.
+
+  site24x7 tag create env --value prod --color "#B7DA9E"
+
+https://www.site24x7.com/help/api/#create-tag`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := tag.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, tag.Table)
+	},
+}
+
+// tagGetCmd represents the `tag get` subcommand
+var tagGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific tag",
+	Long: `Retrieves a specific tag.
+
+https://www.site24x7.com/help/api/#retrieve-tag`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := tag.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		j, err := tag.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, tag.Table)
+	},
+}
+
+// tagUpdateCmd represents the `tag update` subcommand
+var tagUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing tag",
+	Long: `Updates an existing tag, e.g.
+
+  site24x7 tag update env=prod --value production
+
+https://www.site24x7.com/help/api/#update-tag`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := tag.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		json, err := tag.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, tag.Table)
+	},
+}
+
+// tagDeleteCmd represents the `tag delete` subcommand
+var tagDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific tag",
+	Long: `Deletes a specific tag.
+
+https://www.site24x7.com/help/api/#delete-tag`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := tag.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := tag.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Tag", "deleted")
+
+		return nil
+	},
+}
+
+// tagListCmd represents the `tag list` subcommand
+var tagListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all tags",
+	Long: `Retrieves a list of all tags.
+
+https://www.site24x7.com/help/api/#list-tags`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := tag.List(cmd.Context(), cmd.Flags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, tag.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(tagCmd)
+	tagCmd.AddCommand(tagCreateCmd)
+	tagCmd.AddCommand(tagGetCmd)
+	tagCmd.AddCommand(tagUpdateCmd)
+	tagCmd.AddCommand(tagDeleteCmd)
+	tagCmd.AddCommand(tagListCmd)
+
+	// Flags for the `tag create` command
+	tagCreateCmd.Flags().AddFlagSet(tag.GetWriterFlags())
+
+	// Flags for the `tag update` command
+	tagUpdateCmd.Flags().String("name", "", "New name of the tag")
+	tagUpdateCmd.Flags().AddFlagSet(tag.GetWriterFlags())
+}
@@ -0,0 +1,189 @@
+/*
+Copyright © 2021 NAME HERE <EMAIL ADDRESS>
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"fmt"
+	"site24x7/api"
+	"site24x7/cmd/impl/output"
+	"site24x7/cmd/impl/thresholdprofile"
+	"site24x7/logger"
+
+	"github.com/spf13/cobra"
+)
+
+// thresholdProfileCmd represents the `threshold_profile` command
+var thresholdProfileCmd = &cobra.Command{
+	Use:   "threshold_profile <command>",
+	Short: "Performs threshold and availability profile actions",
+	Long: `Performs threshold and availability profile actions.
+
+https://www.site24x7.com/help/api/#threshold-profiles`,
+	Aliases: []string{"tp", "thresholdprofile", "threshold"},
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		// set the log verbosity for any threshold_profile command execution
+		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
+	},
+}
+
+// thresholdProfileCreateCmd represents the `threshold_profile create` subcommand
+var thresholdProfileCreateCmd = &cobra.Command{
+	Use:   "create <profile name>",
+	Short: "Creates a new threshold profile",
+	Long: `Creates a new threshold profile.
+
+https://www.site24x7.com/help/api/#create-threshold-profile`,
+	Aliases: []string{"add", "new"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+		json, err := thresholdprofile.Create(cmd.Context(), name, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, thresholdprofile.Table)
+	},
+}
+
+// thresholdProfileGetCmd represents the `threshold_profile get` subcommand
+var thresholdProfileGetCmd = &cobra.Command{
+	Use:   "get <id>",
+	Short: "Retrieves a specific threshold profile",
+	Long: `Retrieves a specific threshold profile.
+
+https://www.site24x7.com/help/api/#retrieve-threshold-profile`,
+	Aliases: []string{"fetch", "retrieve", "read"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		j, err := thresholdprofile.Get(cmd.Context(), id)
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), j, thresholdprofile.Table)
+	},
+}
+
+// thresholdProfileUpdateCmd represents the `threshold_profile update` subcommand
+var thresholdProfileUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Updates an existing threshold profile",
+	Long: `Updates an existing threshold profile.
+
+https://www.site24x7.com/help/api/#update-threshold-profile`,
+	Aliases: []string{"modify"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		json, err := thresholdprofile.Update(cmd.Context(), id, cmd.LocalFlags())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, thresholdprofile.Table)
+	},
+}
+
+// thresholdProfileDeleteCmd represents the `threshold_profile delete` subcommand
+var thresholdProfileDeleteCmd = &cobra.Command{
+	Use:   "delete <id>",
+	Short: "Deletes a specific threshold profile",
+	Long: `Deletes a specific threshold profile.
+
+https://www.site24x7.com/help/api/#delete-threshold-profile`,
+	Aliases: []string{"del", "rm", "remove"},
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id := args[0]
+		if err := thresholdprofile.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("Threshold profile", "deleted")
+
+		return nil
+	},
+}
+
+// thresholdProfileListCmd represents the `threshold_profile list` subcommand
+var thresholdProfileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Retrieves a list of all threshold profiles",
+	Long: `Retrieves a list of all threshold profiles.
+
+https://www.site24x7.com/help/api/#list-all-threshold-profiles`,
+	Aliases: []string{"ls"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		json, err := thresholdprofile.List(cmd.Context())
+		if err != nil {
+			return err
+		}
+
+		return output.Render(cmd.Flags(), json, thresholdprofile.Table)
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(thresholdProfileCmd)
+	thresholdProfileCmd.AddCommand(thresholdProfileCreateCmd)
+	thresholdProfileCmd.AddCommand(thresholdProfileGetCmd)
+	thresholdProfileCmd.AddCommand(thresholdProfileUpdateCmd)
+	thresholdProfileCmd.AddCommand(thresholdProfileDeleteCmd)
+	thresholdProfileCmd.AddCommand(thresholdProfileListCmd)
+
+	// Flags for the `threshold_profile create` command
+	thresholdProfileCreateCmd.Flags().AddFlagSet(thresholdprofile.GetWriterFlags())
+
+	// Flags for the `threshold_profile update` command
+	thresholdProfileUpdateCmd.Flags().AddFlagSet(thresholdprofile.GetWriterFlags())
+}
@@ -19,6 +19,7 @@ package cmd
 import (
 	"fmt"
 	"site24x7/api"
+	"site24x7/cmd/impl/output"
 	"site24x7/cmd/impl/user"
 	"site24x7/logger"
 
@@ -30,11 +31,11 @@ var userCmd = &cobra.Command{
 	Use:   "user <command>",
 	Short: "Performs user actions",
 	Long:  `Performs user actions.`,
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		// authenticate before all non-config commands
-		api.Authenticate()
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		// set the log verbosity for any monitor_group command execution
 		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
 	},
 	// Run: func(cmd *cobra.Command, args []string) {
 	//  NOOP - requires subcommand
@@ -67,20 +68,12 @@ Valid resource types: https://www.site24x7.com/help/api/#resource_type_constants
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		email := args[0]
-		json, err := user.Create(email, cmd.Flags())
+		json, err := user.Create(cmd.Context(), email, cmd.LocalFlags())
 		if err != nil {
-			// Handle a user already exists error nicely
-			if err, ok := err.(*api.ConflictError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, user.Table)
 	},
 }
 
@@ -95,19 +88,12 @@ support retrieval by email address, albeit less efficient, for improved
 usability.`,
 	Aliases: []string{"fetch", "retrieve", "read"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		j, err := user.Get(cmd.Flags())
+		j, err := user.Get(cmd.Context(), cmd.Flags())
 		if err != nil {
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(j))
-
-		return nil
+		return output.Render(cmd.Flags(), j, user.Table)
 	},
 }
 
@@ -126,20 +112,12 @@ Valid email formats: https://www.site24x7.com/help/api/#alerting_constants
 Valid resource types: https://www.site24x7.com/help/api/#resource_type_constants`,
 	Aliases: []string{"modify"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		json, err := user.Update(cmd.Flags())
+		json, err := user.Update(cmd.Context(), cmd.LocalFlags())
 		if err != nil {
-			// Handle a known error just a bit more cleanly
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, user.Table)
 	},
 }
 
@@ -151,20 +129,74 @@ var userDeleteCmd = &cobra.Command{
 
 The Site24x7 API only supports removal by user ID, but this CLI will also
 support retrieval by email address, albeit less efficient, for improved
-usability.`,
+usability.
+
+To offboard several users at once, list their email addresses, one per line,
+in a file and pass it with --from-file; the result for each is reported.`,
 	Aliases: []string{"del", "rm", "remove"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		err := user.Delete(cmd.Flags())
+		if path, _ := cmd.Flags().GetString("from-file"); path != "" {
+			// Errors from here on are about the file's contents, not usage
+			cmd.SilenceUsage = true
+			continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+			json, err := user.DeleteFromFile(cmd.Context(), path, continueOnError)
+			if json != nil {
+				if err := output.Render(cmd.Flags(), json, user.BulkTable); err != nil {
+					return err
+				}
+			}
+
+			return err
+		}
+
+		err := user.Delete(cmd.Context(), cmd.Flags())
 		if err != nil {
 			return err
 		}
 
-		logger.Out("User successfully deleted!")
+		success("User", "deleted")
 
 		return nil
 	},
 }
 
+// userImportCmd represents the `user import` subcommand
+var userImportCmd = &cobra.Command{
+	Use:   "import <file>",
+	Short: "Creates users from a CSV file",
+	Long: `Creates a user for each row of a CSV file. The first row names the columns:
+an email column and any of the flags accepted by ` + "`user create`" + `, e.g.
+
+  email,name,role,job title,notify by,alert methods down
+  fred@example.com,Fred Flintstone,Operator,DevOps Engineer,"Email,SMS",Email
+
+Roles, job titles, notification methods, email formats and resource types may
+be given by name or by value. The result for each row is reported; processing
+stops at the first row that fails unless --continue-on-error is given.`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		expectedArgLen := 1
+		actualArgLen := len(args)
+		if actualArgLen != expectedArgLen {
+			return fmt.Errorf("expected %d arguments, received %d", expectedArgLen, actualArgLen)
+		}
+
+		return nil
+	},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		// Errors from here on are about the file's contents, not usage
+		cmd.SilenceUsage = true
+		continueOnError, _ := cmd.Flags().GetBool("continue-on-error")
+		json, err := user.Import(cmd.Context(), args[0], continueOnError)
+		if json != nil {
+			if err := output.Render(cmd.Flags(), json, user.BulkTable); err != nil {
+				return err
+			}
+		}
+
+		return err
+	},
+}
+
 // userListCmd represents the `user list` subcommand
 var userListCmd = &cobra.Command{
 	Use:     "list",
@@ -172,14 +204,12 @@ var userListCmd = &cobra.Command{
 	Long:    `Retrieves a list of all users.`,
 	Aliases: []string{"ls"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		json, err := user.List()
+		json, err := user.List(cmd.Context())
 		if err != nil {
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, user.Table)
 	},
 }
 
@@ -190,6 +220,7 @@ func init() {
 	userCmd.AddCommand(userUpdateCmd)
 	userCmd.AddCommand(userDeleteCmd)
 	userCmd.AddCommand(userListCmd)
+	userCmd.AddCommand(userImportCmd)
 
 	// Here you will define your flags and configuration settings.
 
@@ -219,4 +250,9 @@ func init() {
 	// Flags for the `user delete` command
 	// https://www.site24x7.com/help/api/#delete-user
 	userDeleteCmd.Flags().AddFlagSet(user.GetAccessorFlags())
+	userDeleteCmd.Flags().String("from-file", "", "File of email addresses, one per line, of users to delete")
+	userDeleteCmd.Flags().Bool("continue-on-error", false, "Keep going after a user can't be deleted")
+
+	// Flags for the `user import` command
+	userImportCmd.Flags().Bool("continue-on-error", false, "Keep going after a row can't be imported")
 }
@@ -19,6 +19,7 @@ package cmd
 import (
 	"fmt"
 	"site24x7/api"
+	"site24x7/cmd/impl/output"
 	"site24x7/cmd/impl/usergroup"
 	"site24x7/logger"
 
@@ -29,15 +30,16 @@ import (
 var userGroupCmd = &cobra.Command{
 	Use:   "user_group <command>",
 	Short: "Performs user group actions",
-	Long: `Performs user group actions.
+	Long: `Performs user group actions. Wherever a user group ID is expected, the
+group's display name may be given instead.
 	
 https://www.site24x7.com/help/api/#user-groups`,
 	Aliases: []string{"ug", "usergroup", "ugroup", "usergru"},
-	PersistentPreRun: func(cmd *cobra.Command, args []string) {
-		// authenticate before all non-config commands
-		api.Authenticate()
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 		// set the log verbosity for any monitor_group command execution
 		logger.SetVerbosity(cmd.Flags())
+		// authenticate before all non-config commands
+		return api.Authenticate(cmd.Context())
 	},
 	// Run: func(cmd *cobra.Command, args []string) {
 	//  NOOP - requires subcommand
@@ -64,26 +66,18 @@ https://www.site24x7.com/help/api/#create-user-group`,
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name := args[0]
-		json, err := usergroup.Create(name, cmd.Flags())
+		json, err := usergroup.Create(cmd.Context(), name, cmd.LocalFlags())
 		if err != nil {
-			// Handle a user already exists error nicely
-			if err, ok := err.(*api.ConflictError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, usergroup.Table)
 	},
 }
 
 // userGroupGetCmd represents the `user_group get` subcommand
 var userGroupGetCmd = &cobra.Command{
-	Use:   "get <id>",
+	Use:   "get <id|name>",
 	Short: "Retrieves a specific user group",
 	Long: `Retrieves a specific user group.
 
@@ -99,53 +93,44 @@ https://www.site24x7.com/help/api/#retrieve-user-group`,
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-		j, err := usergroup.Get(id)
+		id, err := usergroup.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		j, err := usergroup.Get(cmd.Context(), id)
 		if err != nil {
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(j))
-
-		return nil
+		return output.Render(cmd.Flags(), j, usergroup.Table)
 	},
 }
 
 // userGroupUpdateCmd represents the `user_group update` subcommand
 var userGroupUpdateCmd = &cobra.Command{
-	Use:   "update <id>",
+	Use:   "update <id|name>",
 	Short: "Updates an existing user group",
 	Long: `Updates an existing user group.
 
 https://www.site24x7.com/help/api/#update-user-group`,
 	Aliases: []string{"modify"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-		json, err := usergroup.Update(id, cmd.Flags())
+		id, err := usergroup.Resolve(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+		json, err := usergroup.Update(cmd.Context(), id, cmd.LocalFlags())
 		if err != nil {
-			// Handle a known error just a bit more cleanly
-			if err, ok := err.(*api.NotFoundError); ok {
-				logger.Warn(err.Error())
-				return nil
-			}
-
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, usergroup.Table)
 	},
 }
 
 // userGroupDeleteCmd represents the `user_group delete` subcommand
 var userGroupDeleteCmd = &cobra.Command{
-	Use:   "delete <id>",
+	Use:   "delete <id|name>",
 	Short: "Deletes a specific user group",
 	Long: `Deletes a specific user group.
 
@@ -161,13 +146,16 @@ https://www.site24x7.com/help/api/#delete-user-group`,
 		return nil
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		id := args[0]
-		err := usergroup.Delete(id)
+		id, err := usergroup.Resolve(cmd.Context(), args[0])
 		if err != nil {
 			return err
 		}
 
-		logger.Out("User group successfully deleted!")
+		if err := usergroup.Delete(cmd.Context(), id); err != nil {
+			return err
+		}
+
+		success("User group", "deleted")
 
 		return nil
 	},
@@ -182,14 +170,12 @@ var userGroupListCmd = &cobra.Command{
 https://www.site24x7.com/help/api/#list-of-all-user-groups`,
 	Aliases: []string{"ls"},
 	RunE: func(cmd *cobra.Command, args []string) error {
-		json, err := usergroup.List()
+		json, err := usergroup.List(cmd.Context())
 		if err != nil {
 			return err
 		}
 
-		logger.Out(string(json))
-
-		return nil
+		return output.Render(cmd.Flags(), json, usergroup.Table)
 	},
 }
 
@@ -0,0 +1,225 @@
+// The config/ package resolves settings stored in the CLI's configuration file,
+// which holds one or more named profiles:
+//
+//	active_profile: production
+//	profiles:
+//	  production:
+//	    auth:
+//	      client_id: ...
+//	  staging:
+//	    auth:
+//	      client_id: ...
+//
+// Configuration files written before profiles existed hold a single, flat auth
+// block; that block is treated as the default profile and folded into it the
+// next time the file is saved.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// DefaultProfile is the profile used when none has been selected
+const DefaultProfile = "default"
+
+// activeProfileKey identifies the profile selected by `config use`
+const activeProfileKey = "active_profile"
+
+// profile is the name of the profile in use by the current command
+var profile = DefaultProfile
+
+// overrides holds values that apply to the current command only (e.g. those
+// passed as flags) and must never be written to the configuration file
+var overrides = map[string]string{}
+
+// SetProfile selects the profile used by the current command. An empty name
+// selects the profile chosen by `config use` or, failing that, the default.
+// A name can't contain a ".", which viper takes to separate nested keys.
+func SetProfile(name string) error {
+	if name == "" {
+		name = viper.GetString(activeProfileKey)
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("invalid profile name (%s); a profile name can't contain \".\"", name)
+	}
+
+	profile = name
+
+	return nil
+}
+
+// Profile returns the name of the profile in use by the current command
+func Profile() string {
+	return profile
+}
+
+// ActiveProfile returns the name of the profile selected by `config use`
+func ActiveProfile() string {
+	if p := viper.GetString(activeProfileKey); p != "" {
+		return p
+	}
+
+	return DefaultProfile
+}
+
+// Key returns the fully qualified configuration key of a profile setting
+func Key(key string) string {
+	return fmt.Sprintf("profiles.%s.%s", profile, key)
+}
+
+// Override sets a value that takes precedence over the profile's stored value
+// for the life of the current command.
+func Override(key string, value string) {
+	overrides[key] = value
+}
+
+// GetString returns a setting of the current profile
+func GetString(key string) string {
+	if v, ok := overrides[key]; ok {
+		return v
+	}
+
+	return GetProfileString(profile, key)
+}
+
+// GetProfileString returns a setting of a named profile as stored
+func GetProfileString(name string, key string) string {
+	k := fmt.Sprintf("profiles.%s.%s", name, key)
+	if viper.IsSet(k) {
+		return viper.GetString(k)
+	}
+	// Fall back to the flat layout that predates profiles
+	if name == DefaultProfile && !stored(DefaultProfile) {
+		return viper.GetString(key)
+	}
+
+	return ""
+}
+
+// Set stores a setting of the current profile; call Save to persist it
+func Set(key string, value any) {
+	viper.Set(Key(key), value)
+}
+
+// Profiles returns the sorted names of all configured profiles
+func Profiles() []string {
+	var names []string
+	for name := range viper.GetStringMap("profiles") {
+		names = append(names, name)
+	}
+	if legacy() {
+		names = append(names, DefaultProfile)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Exists reports whether a profile has been configured
+func Exists(name string) bool {
+	return stored(name) || (name == DefaultProfile && legacy())
+}
+
+// Use makes a profile the one used when no other has been requested
+func Use(name string) error {
+	if !Exists(name) {
+		return fmt.Errorf("profile %s does not exist; run `site24x7 config --profile %s` to create it", name, name)
+	}
+
+	viper.Set(activeProfileKey, name)
+
+	return Save()
+}
+
+// Delete removes a profile from the configuration file
+func Delete(name string) error {
+	if !Exists(name) {
+		return fmt.Errorf("profile %s does not exist", name)
+	}
+
+	return save(name)
+}
+
+// Save writes every profile to the configuration file
+func Save() error {
+	return save("")
+}
+
+// Path returns the location of the configuration file
+func Path() string {
+	if f := viper.ConfigFileUsed(); f != "" {
+		return f
+	}
+
+	home, _ := os.UserHomeDir()
+
+	return filepath.Join(home, ".site24x7.yaml")
+}
+
+// save writes the configuration file, omitting a profile that's being deleted.
+// Viper can't unset a key, so the file is written from a fresh instance and
+// then read back, which leaves the environment settings and the values of the
+// current command in place.
+func save(without string) error {
+	settings := viper.AllSettings()
+
+	profiles, ok := settings["profiles"].(map[string]interface{})
+	if !ok {
+		profiles = map[string]interface{}{}
+	}
+
+	// Fold any flat, pre-profile auth block into the default profile
+	if flat, ok := settings["auth"]; ok {
+		if _, exists := profiles[DefaultProfile]; !exists {
+			profiles[DefaultProfile] = map[string]interface{}{"auth": flat}
+		}
+		delete(settings, "auth")
+	}
+
+	if without != "" {
+		delete(profiles, without)
+		if settings[activeProfileKey] == without {
+			delete(settings, activeProfileKey)
+		}
+	}
+	settings["profiles"] = profiles
+
+	f := Path()
+	out := viper.New()
+	for k, v := range settings {
+		out.Set(k, v)
+	}
+	if err := out.WriteConfigAs(f); err != nil {
+		return fmt.Errorf("[config.save] Unable to write %s (%s)", f, err)
+	}
+	// Only the owner should be able to read credentials
+	os.Chmod(f, 0600)
+
+	// Reload so that the running command sees what was written
+	viper.SetConfigFile(f)
+
+	return viper.ReadInConfig()
+}
+
+// stored reports whether a profile exists in the profiles section
+func stored(name string) bool {
+	_, ok := viper.GetStringMap("profiles")[name]
+
+	return ok
+}
+
+// legacy reports whether the configuration holds a flat, pre-profile auth
+// block that hasn't been superseded by a default profile
+func legacy() bool {
+	return viper.IsSet("auth") && !stored(DefaultProfile)
+}
@@ -0,0 +1,198 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// loadConfig points viper at a temporary configuration file with the given
+// contents
+func loadConfig(t *testing.T, contents string) {
+	f := filepath.Join(t.TempDir(), ".site24x7.yaml")
+	if err := os.WriteFile(f, []byte(contents), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Reset()
+	viper.SetConfigFile(f)
+	if err := viper.ReadInConfig(); err != nil {
+		t.Fatal(err)
+	}
+
+	overrides = map[string]string{}
+	SetProfile("")
+}
+
+const legacyConfig = `
+auth:
+  client_id: legacy-id
+`
+
+const profileConfig = `
+active_profile: staging
+profiles:
+  default:
+    auth:
+      client_id: default-id
+  staging:
+    auth:
+      client_id: staging-id
+      data_center: EU
+`
+
+func TestGetString(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   string
+		profile  string
+		override string
+		want     string
+	}{
+		{
+			name:   "Reads the flat, pre-profile layout as the default profile",
+			config: legacyConfig,
+			want:   "legacy-id",
+		},
+		{
+			name:   "Reads the profile selected by `config use`",
+			config: profileConfig,
+			want:   "staging-id",
+		},
+		{
+			name:    "Reads an explicitly requested profile",
+			config:  profileConfig,
+			profile: "default",
+			want:    "default-id",
+		},
+		{
+			name:    "Returns nothing for an unknown profile",
+			config:  profileConfig,
+			profile: "production",
+			want:    "",
+		},
+		{
+			name:     "Prefers an override",
+			config:   profileConfig,
+			override: "override-id",
+			want:     "override-id",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadConfig(t, tt.config)
+			if tt.profile != "" {
+				SetProfile(tt.profile)
+			}
+			if tt.override != "" {
+				Override("auth.client_id", tt.override)
+			}
+			if got := GetString("auth.client_id"); got != tt.want {
+				t.Errorf("GetString() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestProfiles(t *testing.T) {
+	tests := []struct {
+		name   string
+		config string
+		want   []string
+	}{
+		{
+			name:   "Lists the flat, pre-profile layout as the default profile",
+			config: legacyConfig,
+			want:   []string{"default"},
+		},
+		{
+			name:   "Lists named profiles",
+			config: profileConfig,
+			want:   []string{"default", "staging"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadConfig(t, tt.config)
+			if got := Profiles(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Profiles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUse(t *testing.T) {
+	loadConfig(t, profileConfig)
+
+	if err := Use("production"); err == nil {
+		t.Errorf("Use() expected an error for an unknown profile")
+	}
+	if err := Use("default"); err != nil {
+		t.Fatalf("Use() error = %v", err)
+	}
+	if got := ActiveProfile(); got != "default" {
+		t.Errorf("ActiveProfile() = %v, want default", got)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	loadConfig(t, profileConfig)
+
+	if err := Delete("staging"); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got := Profiles(); !reflect.DeepEqual(got, []string{"default"}) {
+		t.Errorf("Profiles() = %v, want [default]", got)
+	}
+	if got := ActiveProfile(); got != DefaultProfile {
+		t.Errorf("ActiveProfile() = %v, want %v", got, DefaultProfile)
+	}
+}
+
+func TestSave(t *testing.T) {
+	loadConfig(t, legacyConfig)
+
+	SetProfile("staging")
+	Set("auth.client_id", "staging-id")
+	if err := Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if viper.IsSet("auth") {
+		t.Errorf("Save() kept the flat, pre-profile auth block")
+	}
+	if got := GetProfileString("default", "auth.client_id"); got != "legacy-id" {
+		t.Errorf("GetProfileString() = %v, want legacy-id", got)
+	}
+	if got := GetProfileString("staging", "auth.client_id"); got != "staging-id" {
+		t.Errorf("GetProfileString() = %v, want staging-id", got)
+	}
+}
+
+func TestSave_keepsEnvironment(t *testing.T) {
+	loadConfig(t, profileConfig)
+	viper.AutomaticEnv()
+	t.Setenv("SITE24X7_TEST_SETTING", "from-env")
+
+	if err := Save(); err != nil {
+		t.Fatalf("Save() error = %v", err)
+	}
+
+	if got := viper.GetString("site24x7_test_setting"); got != "from-env" {
+		t.Errorf("viper.GetString() = %v, want from-env", got)
+	}
+}
+
+func TestSetProfile(t *testing.T) {
+	loadConfig(t, profileConfig)
+
+	if err := SetProfile("acme.staging"); err == nil {
+		t.Errorf("SetProfile() accepted a name containing \".\"")
+	}
+	if got := Profile(); got != "staging" {
+		t.Errorf("Profile() = %v, want staging", got)
+	}
+}